@@ -92,6 +92,11 @@ var NewController = component.Declare(
 					"jitter aggregationRate with a uniformly distributed multiplier [jitter-low, jitter-high]",
 				),
 			},
+			historyLagThreshold: fs.Duration(
+				"history-lag-threshold",
+				time.Second*10,
+				"set the HistoryLagging condition if aggregation lags behind admission history by this duration",
+			),
 		}
 	},
 	func(args ControllerArgs, requests *component.DepRequests) ControllerDeps {
@@ -163,6 +168,8 @@ type ControllerOptions struct {
 	podInformerShards *int
 	podRelistPeriod   *time.Duration
 	aggRateJitter     [2]*float64
+
+	historyLagThreshold *time.Duration
 }
 
 type ControllerDeps struct {
@@ -574,6 +581,9 @@ func reconcile(
 			defaultConfig: deps.defaultConfig.Get(),
 			clk:           args.Clock,
 			pprSelector:   *options.pprLabelSelector,
+			conditionThresholds: pprutil.ConditionThresholds{
+				HistoryLag: *options.historyLagThreshold,
+			},
 		},
 		obs,
 		queue,
@@ -591,6 +601,8 @@ type reconcileOptions struct {
 	defaultConfig *defaultconfig.Options
 	clk           clock.Clock
 	pprSelector   labels.Selector
+
+	conditionThresholds pprutil.ConditionThresholds
 }
 
 //nolint:cyclop // flow is mostly linear; abstracting code to functions isn't going to significantly improve readability.
@@ -632,7 +644,7 @@ func tryReconcile(
 
 	ppr = ppr.DeepCopy()
 
-	relevantPods, err := findRelevantPods(caches, ppr)
+	relevantPods, err := findRelevantPods(caches, queueItem, ppr)
 	if err != nil {
 		return observer.EndReconcile{
 			Err:       err,
@@ -680,12 +692,18 @@ func tryReconcile(
 
 	minLastEventTime := iter.FromSlice(lastEventTimeByShard).Extremum(time.Time.After).MustGet("number of shards is nonzero")
 
+	retainObservedAfter := optional.None[time.Time]()
+	if rate := ppr.Spec.DisruptionRate; rate != nil {
+		retainObservedAfter = optional.Some(options.clk.Now().Add(-rate.Window.Duration))
+	}
+
 	updateLastEventTime(
 		caches, queueItem, status, &hasChange,
 		lastEventTimeList{
 			shards: lastEventTimeByShard,
 			min:    minLastEventTime,
 		},
+		retainObservedAfter,
 	)
 
 	if hasChange.HasChanged() {
@@ -696,10 +714,25 @@ func tryReconcile(
 		queue.EnqueueDelayed(queueItem, requeue)
 	}
 
-	if hasChange.HasChanged() {
-		computedConfig := options.defaultConfig.Compute(optional.Some(ppr.Spec.AdmissionHistoryConfig))
-		pprutil.Summarize(computedConfig, ppr)
+	computedConfig := options.defaultConfig.Compute(optional.Some(ppr.Spec.AdmissionHistoryConfig))
+	now := options.clk.Now()
+	pprutil.Summarize(computedConfig, ppr, now)
+
+	// Conditions are re-evaluated on every reconcile since they may depend on the current time.
+	// Without pod events, the PodProtector is requeued when the next cell would become stale.
+	// Note that if this is the only aggregator writing the status (e.g. in a single-cell setup),
+	// staleness of the own cell of this aggregator is only reported by other aggregators,
+	// since a stopped aggregator cannot report itself.
+	conditionsChanged, recheckAfter := pprutil.UpdateConditions(computedConfig, ppr, now, options.conditionThresholds)
+	if conditionsChanged {
+		hasChange.Add(observer.StatusChangeCauseConditions)
+	}
+
+	if recheckAfter, shouldRecheck := recheckAfter.Get(); shouldRecheck {
+		queue.EnqueueDelayed(queueItem, recheckAfter)
+	}
 
+	if hasChange.HasChanged() {
 		err := caches.pprSourceProvider.
 			UpdateStatus(ctx, queueItem.SourceName, ppr)
 		if err != nil {
@@ -722,35 +755,47 @@ func tryReconcile(
 	}
 }
 
-func findRelevantPods(caches *Caches, ppr *podseidonv1a1.PodProtector) ([]*corev1.Pod, error) {
-	podNames, err := caches.podIndex.Query(labelindex.NamespacedQuery[metav1.LabelSelector]{
-		Namespace: ppr.Namespace,
-		Query:     pprutil.GetAggregationSelector(ppr),
-	})
+func findRelevantPods(
+	caches *Caches,
+	key pprutil.PodProtectorKey,
+	ppr *podseidonv1a1.PodProtector,
+) ([]*corev1.Pod, error) {
+	// ClusterPodProtectors aggregate pods from all namespaces matching the namespace selector.
+	namespaces, err := caches.pprInformer.MatchingNamespaces(key)
 	if err != nil {
-		return nil, errors.TagWrapf(
-			"QueryPodIndex",
-			err,
-			"query pods matching PodProtector selector from index",
-		)
+		return nil, errors.TagWrapf("MatchingNamespaces", err, "list namespaces protected by PodProtector")
 	}
 
 	relevantPods := []*corev1.Pod{}
 
-	if err := podNames.TryForEach(func(podName types.NamespacedName) error {
-		pod, err := caches.findPodFromAnyShard(podName)
+	for _, namespace := range namespaces {
+		podNames, err := caches.podIndex.Query(labelindex.NamespacedQuery[metav1.LabelSelector]{
+			Namespace: namespace,
+			Query:     pprutil.GetAggregationSelector(ppr),
+		})
 		if err != nil {
-			return errors.TagWrapf("PodListerGet", err, "get pod from lister")
+			return nil, errors.TagWrapf(
+				"QueryPodIndex",
+				err,
+				"query pods matching PodProtector selector from index",
+			)
 		}
 
-		if pod != nil && pod.DeletionTimestamp.IsZero() {
-			// Possible race condition: store is updated but event handler is not called yet to untrack the pod
-			relevantPods = append(relevantPods, pod)
-		}
+		if err := podNames.TryForEach(func(podName types.NamespacedName) error {
+			pod, err := caches.findPodFromAnyShard(podName)
+			if err != nil {
+				return errors.TagWrapf("PodListerGet", err, "get pod from lister")
+			}
 
-		return nil
-	}); err != nil {
-		return nil, err
+			if pod != nil && pod.DeletionTimestamp.IsZero() {
+				// Possible race condition: store is updated but event handler is not called yet to untrack the pod
+				relevantPods = append(relevantPods, pod)
+			}
+
+			return nil
+		}); err != nil {
+			return nil, err
+		}
 	}
 
 	return relevantPods, nil
@@ -868,6 +913,9 @@ func isPodAvailable(
 
 // Clean up obsolete admission history observed by the current aggregation.
 //
+// Obsolete buckets ending after retainObservedAfter are moved to the observed history
+// for disruption rate limiting instead of being removed.
+//
 //nolint:gocognit // prefer laying out all branches explicitly for clarity of each scenario.
 func updateLastEventTime(
 	caches *Caches,
@@ -875,10 +923,29 @@ func updateLastEventTime(
 	outputStatus *podseidonv1a1.PodProtectorCellStatus,
 	outputChanged *haschange.Changed[observer.StatusChangeCause],
 	lastEventTime lastEventTimeList,
+	retainObservedAfter optional.Optional[time.Time],
 ) {
 	changed := false
 	newBuckets := []podseidonv1a1.PodProtectorAdmissionBucket{}
 
+	newObserved := []podseidonv1a1.PodProtectorAdmissionBucket{}
+	retainObserved := func(bucket podseidonv1a1.PodProtectorAdmissionBucket) {
+		endTime := bucket.StartTime.Time
+		if bucket.EndTime != nil {
+			endTime = bucket.EndTime.Time
+		}
+
+		if retainAfter, retain := retainObservedAfter.Get(); retain && endTime.After(retainAfter) {
+			newObserved = append(newObserved, *bucket.DeepCopy())
+		} else {
+			changed = true
+		}
+	}
+
+	for _, bucket := range outputStatus.History.Observed {
+		retainObserved(bucket)
+	}
+
 	shardHasOutstandingBuckets := make([]bool, len(caches.podInformerShards))
 
 	for _, bucket := range outputStatus.History.Buckets {
@@ -899,6 +966,8 @@ func updateLastEventTime(
 				// Obsoleted by the current aggregation.
 				// Do not copy to the new bucket list, no matter pod is aggregated or not.
 				changed = true
+
+				retainObserved(bucket)
 			} else {
 				// The effect of this admission has not been observed by this aggregation yet.
 				//
@@ -934,6 +1003,8 @@ func updateLastEventTime(
 				// The entire bucket is obsoleted by the current aggregation.
 				// Do not copy to the new bucket list.
 				changed = true
+
+				retainObserved(bucket)
 			} else {
 				// Some or all of the bucket is not covered by the current aggregation.
 				// Produce an observer event that warns about this.
@@ -953,6 +1024,7 @@ func updateLastEventTime(
 
 	if changed {
 		outputStatus.History.Buckets = newBuckets
+		outputStatus.History.Observed = newObserved
 		outputChanged.Add(observer.StatusChangeCauseHistoryBucketAggregated)
 	}
 
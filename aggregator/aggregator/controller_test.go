@@ -312,9 +312,11 @@ func testReconcile(
 		component.ApiOnly("worker-kube", tc.WorkerClient(clk)),
 		component.ApiOnly("observer-aggregator", obs),
 		component.ApiOnly("default-admission-history-config", &defaultconfig.Options{
-			MaxConcurrentLag: ptr.To(int32(0)),
-			CompactThreshold: ptr.To(int32(100)),
-			AggregationRate:  ptr.To(time.Duration(0)),
+			MaxConcurrentLag:   ptr.To(int32(0)),
+			CompactThreshold:   ptr.To(int32(100)),
+			AggregationRate:    ptr.To(time.Duration(0)),
+			StaleCellThreshold: ptr.To(time.Duration(0)),
+			StaleCellPolicy:    ptr.To(podseidonv1a1.StaleCellPolicyEstimated),
 		}),
 		synctime.ProvideClock(clk, true),
 		component.RequireDep(aggregator.NewController(aggregator.ControllerArgs{
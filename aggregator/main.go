@@ -20,8 +20,9 @@ import (
 	"github.com/kubewharf/podseidon/util/cmd"
 	"github.com/kubewharf/podseidon/util/component"
 	healthzobserver "github.com/kubewharf/podseidon/util/healthz/observer"
+	httpobserver "github.com/kubewharf/podseidon/util/http/observer"
 	kubeobserver "github.com/kubewharf/podseidon/util/kube/observer"
-	"github.com/kubewharf/podseidon/util/o11y/metrics"
+	metricshttp "github.com/kubewharf/podseidon/util/o11y/metrics/http"
 	pprutil "github.com/kubewharf/podseidon/util/podprotector"
 	pprutilobserver "github.com/kubewharf/podseidon/util/podprotector/observer"
 	"github.com/kubewharf/podseidon/util/pprof"
@@ -37,8 +38,9 @@ import (
 func main() {
 	cmd.Run(
 		component.RequireDep(pprof.New(util.Empty{})),
-		component.RequireDep(metrics.NewHttp(metrics.HttpArgs{})),
+		component.RequireDep(metricshttp.New(metricshttp.Args{})),
 		healthzobserver.Provide,
+		httpobserver.Provide,
 		workerobserver.Provide,
 		kubeobserver.ProvideElector,
 		aggregatorobserver.Provide,
@@ -20,8 +20,9 @@ import (
 	"github.com/kubewharf/podseidon/util/cmd"
 	"github.com/kubewharf/podseidon/util/component"
 	healthzobserver "github.com/kubewharf/podseidon/util/healthz/observer"
+	httpobserver "github.com/kubewharf/podseidon/util/http/observer"
 	kubeobserver "github.com/kubewharf/podseidon/util/kube/observer"
-	"github.com/kubewharf/podseidon/util/o11y/metrics"
+	metricshttp "github.com/kubewharf/podseidon/util/o11y/metrics/http"
 	pprutil "github.com/kubewharf/podseidon/util/podprotector"
 	pprutilobserver "github.com/kubewharf/podseidon/util/podprotector/observer"
 	"github.com/kubewharf/podseidon/util/pprof"
@@ -36,7 +37,13 @@ import (
 	"github.com/kubewharf/podseidon/generator/monitor"
 	generatorobserver "github.com/kubewharf/podseidon/generator/observer"
 	"github.com/kubewharf/podseidon/generator/resource"
+	"github.com/kubewharf/podseidon/generator/resource/daemonset"
 	"github.com/kubewharf/podseidon/generator/resource/deployment"
+	"github.com/kubewharf/podseidon/generator/resource/generic"
+	"github.com/kubewharf/podseidon/generator/resource/pdb"
+	"github.com/kubewharf/podseidon/generator/resource/statefulset"
+	"github.com/kubewharf/podseidon/generator/shadowpdb"
+	"github.com/kubewharf/podseidon/webhook/certprovider"
 	"github.com/kubewharf/podseidon/webhook/handler"
 	webhookobserver "github.com/kubewharf/podseidon/webhook/observer"
 	webhookserver "github.com/kubewharf/podseidon/webhook/server"
@@ -45,11 +52,12 @@ import (
 func main() {
 	cmd.Run(
 		component.RequireDep(pprof.New(util.Empty{})),
-		component.RequireDep(metrics.NewHttp(metrics.HttpArgs{})),
+		component.RequireDep(metricshttp.New(metricshttp.Args{})),
 		workerobserver.Provide,
 		kubeobserver.ProvideElector,
 		pprutilobserver.ProvideInformer,
 		healthzobserver.Provide,
+		httpobserver.Provide,
 		generatorobserver.Provide,
 		aggregatorobserver.Provide,
 		webhookobserver.Provide,
@@ -62,12 +70,22 @@ func main() {
 			generator.ControllerArgs{
 				Types: []component.Declared[resource.TypeProvider]{
 					deployment.New(util.Empty{}),
+					statefulset.New(util.Empty{}),
+					daemonset.New(util.Empty{}),
+					pdb.New(util.Empty{}),
+				},
+				TypeSets: []component.Declared[[]resource.TypeProvider]{
+					generic.New(util.Empty{}),
 				},
 			},
 		)),
 		component.RequireDep(monitor.New(monitor.Args{})),
+		component.RequireDep(shadowpdb.New(shadowpdb.Args{})),
 		component.RequireDep(webhookserver.New(webhookserver.Args{})),
 		pprutil.RequireSingleSourceProvider(pprutil.SingleSourceProviderArgs{ClusterName: "core"}, true),
 		handler.DefaultRequiresPodNameImpls,
+		handler.DefaultDeleterIdentityImpls,
+		handler.DefaultPodGetterImpls,
+		certprovider.DefaultImpls,
 	)
 }
@@ -35,6 +35,20 @@ const (
 // to declare that a dangling PodProtector shall no longer be maintained.
 const GeneratorFinalizer = "podseidon.kubewharf.io/generator"
 
+// Labels a PodDisruptionBudget maintained by generator as a mirror of a PodProtector.
+//
+// The value is the name of the mirrored PodProtector in the same namespace.
+// Shadow PodDisruptionBudgets only exist for the reference of PDB-aware tooling
+// (e.g. cluster-autoscaler, descheduler, `kubectl drain`);
+// they are never imported back as PodProtectors.
+const ShadowPdbLabel = "podseidon.kubewharf.io/shadow-of"
+
+// Labels a PodProtector as a member of a PodProtectorGroup.
+//
+// The value is the name of the PodProtectorGroup in the same namespace.
+// Generator propagates this label from the source workload to the generated PodProtector.
+const PprGroupLabel = "podseidon.kubewharf.io/group"
+
 // A convenience hack to remove the entries for cells that are no longer online.
 //
 // The value of this annotation is a comma-separated list of cell names.
@@ -63,4 +77,46 @@ const (
 	AuditAnnotationDryRun = "dry-run"
 	// Indicates the PodProtector object that denied the request.
 	AuditAnnotationRejectByPpr = "reject-by-podprotector"
+	// Indicates the PodProtectorGroup object that denied the request.
+	AuditAnnotationRejectByGroup = "reject-by-podprotectorgroup"
+	// Indicates the webhook exemption rule matched by the request.
+	AuditAnnotationExemptionRule = "exemption-rule"
+)
+
+// Reason codes for rejected admission reviews,
+// reported as the `podseidon.kubewharf.io/reason` cause in the details of the rejection status.
+// These values are stable and may be used by clients to decide how to react to a rejection.
+const (
+	// The PodProtector or PodProtectorGroup does not have enough available pods to admit the deletion.
+	RejectionReasonInsufficientAvailability = "InsufficientAvailability"
+	// The quota is reserved by other deletions that have not been observed by aggregator yet.
+	// The request may succeed on retry.
+	RejectionReasonAdmissionBufferFull = "AdmissionBufferFull"
+	// The disruption rate of the PodProtector is exhausted.
+	// The request may succeed on retry.
+	RejectionReasonDisruptionRateExhausted = "DisruptionRateExhausted"
+	// The PodProtector is in a freeze window.
+	RejectionReasonFrozen = "Frozen"
+	// The webhook received a request that it does not handle.
+	RejectionReasonNotRelevant = "NotRelevant"
+	// The webhook failed to process the request.
+	RejectionReasonInternalError = "InternalError"
+	// The quota could not be reserved before the admission deadline requested by apiserver.
+	// The request may succeed on retry.
+	RejectionReasonDeadlineExceeded = "DeadlineExceeded"
+)
+
+// Cause types in the details of the rejection status.
+//
+// Each cause carries a single value in its message.
+// Quota numbers are only reported when the rejection was determined by a quota.
+const (
+	StatusCauseReason              = "podseidon.kubewharf.io/reason"
+	StatusCauseNamespace           = "podseidon.kubewharf.io/namespace"
+	StatusCauseMinAvailable        = "podseidon.kubewharf.io/minAvailable"
+	StatusCauseAggregatedAvailable = "podseidon.kubewharf.io/aggregatedAvailable"
+	StatusCauseEstimatedAvailable  = "podseidon.kubewharf.io/estimatedAvailable"
+	StatusCauseQuotaCleared        = "podseidon.kubewharf.io/quotaCleared"
+	StatusCauseQuotaTransitional   = "podseidon.kubewharf.io/quotaTransitional"
+	StatusCauseStaleCells          = "podseidon.kubewharf.io/staleCells"
 )
@@ -42,7 +42,12 @@ func init() {
 }
 
 func addKnownTypes(scheme *runtime.Scheme) error {
-	scheme.AddKnownTypes(SchemeGroupVersion, &PodProtector{}, &PodProtectorList{})
+	scheme.AddKnownTypes(
+		SchemeGroupVersion,
+		&PodProtector{}, &PodProtectorList{},
+		&ClusterPodProtector{}, &ClusterPodProtectorList{},
+		&PodProtectorGroup{}, &PodProtectorGroupList{},
+	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
 }
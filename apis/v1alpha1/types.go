@@ -17,6 +17,7 @@ package v1alpha1
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // +genclient
@@ -25,9 +26,11 @@ import (
 // +kubebuilder:resource:path=podprotectors,shortName=ppr
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
-// +kubebuilder:printcolumn:name="Required",type=string,JSONPath=".spec.minAvailable"
+// +kubebuilder:printcolumn:name="Required",type=integer,JSONPath=".status.summary.minAvailable"
 // +kubebuilder:printcolumn:name="Aggregated",type=integer,JSONPath=".status.summary.aggregatedAvailableReplicas"
-// +kubebuilder:printcolumn:name="Estimated",type=integer,JSONPath=".status.summary.aggregatedAvailableReplicas"
+// +kubebuilder:printcolumn:name="Estimated",type=integer,JSONPath=".status.summary.estimatedAvailableReplicas"
+// +kubebuilder:printcolumn:name="At risk",type=string,JSONPath=".status.conditions[?(@.type==\"AtRisk\")].status"
+// +kubebuilder:printcolumn:name="Stale cell",type=string,JSONPath=".status.conditions[?(@.type==\"StaleCell\")].status",priority=1
 // +kubebuilder:printcolumn:name="Bucket lag",type=integer,JSONPath=".status.summary.maxLatencyMillis",priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=".metadata.creationTimestamp"
 
@@ -51,11 +54,132 @@ type PodProtectorList struct {
 	Items           []PodProtector `json:"items"`
 }
 
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:validation:Required
+// +kubebuilder:resource:path=clusterpodprotectors,shortName=cppr,scope=Cluster
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Required",type=integer,JSONPath=".status.summary.minAvailable"
+// +kubebuilder:printcolumn:name="Aggregated",type=integer,JSONPath=".status.summary.aggregatedAvailableReplicas"
+// +kubebuilder:printcolumn:name="Estimated",type=integer,JSONPath=".status.summary.estimatedAvailableReplicas"
+// +kubebuilder:printcolumn:name="At risk",type=string,JSONPath=".status.conditions[?(@.type==\"AtRisk\")].status"
+// +kubebuilder:printcolumn:name="Stale cell",type=string,JSONPath=".status.conditions[?(@.type==\"StaleCell\")].status",priority=1
+// +kubebuilder:printcolumn:name="Bucket lag",type=integer,JSONPath=".status.summary.maxLatencyMillis",priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=".metadata.creationTimestamp"
+
+// Protects pods across all namespaces matching a namespace selector.
+// Each ClusterPodProtector behaves like a single PodProtector spanning all selected namespaces.
+type ClusterPodProtector struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ClusterPodProtectorSpec `json:"spec"`
+	Status            PodProtectorStatus      `json:"status,omitempty"`
+}
+
+const (
+	ClusterPodProtectorKind     = "ClusterPodProtector"
+	ClusterPodProtectorResource = "clusterpodprotectors"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ClusterPodProtectorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterPodProtector `json:"items"`
+}
+
+type ClusterPodProtectorSpec struct {
+	// Selects the namespaces of protected pods by the labels of Namespace objects
+	// in the cluster hosting the ClusterPodProtector.
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+
+	PodProtectorSpec `json:",inline"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:validation:Required
+// +kubebuilder:resource:path=podprotectorgroups,shortName=pprg
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Min available",type=integer,JSONPath=".spec.minAvailable"
+// +kubebuilder:printcolumn:name="Max unavailable",type=string,JSONPath=".spec.maxUnavailable"
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=".metadata.creationTimestamp"
+
+// Enforces a combined availability requirement across multiple PodProtectors in the same namespace.
+// Member PodProtectors are labeled with `podseidon.kubewharf.io/group` set to the name of the group.
+type PodProtectorGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              PodProtectorGroupSpec   `json:"spec"`
+	Status            PodProtectorGroupStatus `json:"status,omitempty"`
+}
+
+const (
+	PodProtectorGroupKind     = "PodProtectorGroup"
+	PodProtectorGroupResource = "podprotectorgroups"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type PodProtectorGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PodProtectorGroup `json:"items"`
+}
+
+// Availability requirement for the union of pods aggregated by all member PodProtectors.
+// If both MinAvailable and MaxUnavailable are set, the stricter requirement applies.
+type PodProtectorGroupSpec struct {
+	// Minimum number of available pods to ensure across all members.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinAvailable int32 `json:"minAvailable,omitempty"`
+	// Maximum number of unavailable pods across all members,
+	// as an absolute number or a percentage of the total number of pods in all members.
+	// Percentages are rounded up.
+	// +kubebuilder:validation:XIntOrString
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+type PodProtectorGroupStatus struct {
+	// Deletions admitted against the group requirement
+	// that have not been observed by the aggregator of the member yet.
+	// +optional
+	Admissions []PodProtectorGroupAdmission `json:"admissions,omitempty"`
+}
+
+type PodProtectorGroupAdmission struct {
+	// The member PodProtector through which the pod was admitted.
+	PodProtector string `json:"podProtector"`
+	// The cell of the admitted pod.
+	CellId string `json:"cellId"`
+	// The UID of the admitted pod.
+	PodUid types.UID `json:"podUID"`
+	// The name of the admitted pod.
+	// +optional
+	PodName string `json:"podName,omitempty"`
+	// Time at which the deletion was admitted.
+	StartTime metav1.MicroTime `json:"startTime"`
+}
+
 type PodProtectorSpec struct {
 	// Minimum number of available pods to ensure.
 	// Available pods cannot be deleted if the total availability is less than or equal to this value.
 	// +kubebuilder:validation:Minimum=0
-	MinAvailable int32 `json:"minAvailable"`
+	// +optional
+	MinAvailable int32 `json:"minAvailable,omitempty"`
+	// Maximum number of unavailable pods, as an absolute number or a percentage of `status.summary.totalReplicas`.
+	// Percentages are rounded up.
+	// If both MinAvailable and MaxUnavailable are set, the stricter requirement applies.
+	// The resolved requirement is reported in `status.summary.minAvailable`.
+	// +kubebuilder:validation:XIntOrString
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
 	// Number of seconds for which a pod must maintain readiness before being considered available.
 	MinReadySeconds int32 `json:"minReadySeconds"`
 	// Selects pods to be protected by this object.
@@ -67,8 +191,106 @@ type PodProtectorSpec struct {
 	AggregationSelector *metav1.LabelSelector `json:"aggregationSelector,omitempty"`
 	// Tune parameters for Podseidon components for a specific object.
 	AdmissionHistoryConfig AdmissionHistoryConfig `json:"admissionHistoryConfig,omitempty"`
+	// Availability requirement enforced in each cell independently,
+	// in addition to the global requirement.
+	// +optional
+	CellConstraint *PodProtectorCellConstraint `json:"cellConstraint,omitempty"`
+	// Limits the rate at which pod deletions are admitted,
+	// independently of the availability requirement.
+	// +optional
+	DisruptionRate *PodProtectorDisruptionRate `json:"disruptionRate,omitempty"`
+	// Time-based overrides of the disruption policy.
+	// If multiple windows are active at the same time, the first one in the list takes effect.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Windows []PodProtectorWindow `json:"windows,omitempty"`
+}
+
+// Availability requirement for the pods in a single cell.
+// If both MinAvailable and MaxUnavailable are set, the stricter requirement applies.
+type PodProtectorCellConstraint struct {
+	// Minimum number of available pods to ensure in each cell.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinAvailable int32 `json:"minAvailable,omitempty"`
+	// Maximum number of unavailable pods in each cell,
+	// as an absolute number or a percentage of the `totalReplicas` of the cell.
+	// Percentages are rounded up.
+	// +kubebuilder:validation:XIntOrString
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// Limits the number of admitted pod deletions within a sliding window.
+type PodProtectorDisruptionRate struct {
+	// Maximum number of pod deletions admitted within any window.
+	// +kubebuilder:validation:Minimum=1
+	MaxDisruptions int32 `json:"maxDisruptions"`
+	// Length of the sliding window.
+	Window metav1.Duration `json:"window"`
+}
+
+// A one-off or recurring period during which the disruption policy is overridden.
+//
+// A one-off window is specified with Start and End.
+// A recurring window is specified with Schedule and Duration.
+// Windows that specify neither or both are rejected by validation;
+// windows with a schedule that cannot be parsed are never active
+// and are reported in the InvalidWindow condition.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.start) == has(self.end)",message="start and end must be specified together"
+// +kubebuilder:validation:XValidation:rule="has(self.schedule) == has(self.duration)",message="schedule and duration must be specified together"
+// +kubebuilder:validation:XValidation:rule="has(self.start) != has(self.schedule)",message="exactly one of start/end and schedule/duration must be specified"
+type PodProtectorWindow struct {
+	// Identifies the window in rejection messages.
+	Name string `json:"name"`
+
+	// Start time of a one-off window.
+	// +optional
+	Start *metav1.Time `json:"start,omitempty"`
+	// End time (exclusive) of a one-off window.
+	// +optional
+	End *metav1.Time `json:"end,omitempty"`
+
+	// Cron expression in UTC at which a recurring window starts,
+	// e.g. "0 22 * * 1-5" for 22:00 UTC on weekdays.
+	// +kubebuilder:validation:Pattern=`^\S+(\s+\S+){4}$`
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+	// Length of each recurring window.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// The policy to apply while the window is active.
+	Action PodProtectorWindowAction `json:"action"`
+
+	// Replaces `spec.minAvailable` while an Override window is active.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinAvailable int32 `json:"minAvailable,omitempty"`
+	// Replaces `spec.maxUnavailable` while an Override window is active.
+	// +kubebuilder:validation:XIntOrString
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=Override;Freeze;DryRun
+type PodProtectorWindowAction string
+
+const (
+	// Evaluates deletions against the MinAvailable and MaxUnavailable of the window instead of the spec.
+	PodProtectorWindowActionOverride = PodProtectorWindowAction("Override")
+	// Rejects all deletions of protected pods.
+	PodProtectorWindowActionFreeze = PodProtectorWindowAction("Freeze")
+	// Evaluates deletions without reserving quota, and admits them regardless of the result.
+	//
+	// The evaluation is optimistic: admitted deletions are not recorded in the admission history,
+	// so concurrent deletions within the window are evaluated against the same aggregation.
+	// Deletions that would be rejected are reported as warnings in the admission response.
+	PodProtectorWindowActionDryRun = PodProtectorWindowAction("DryRun")
+)
+
 type AdmissionHistoryConfig struct {
 	// Maximum sum of AdmissionCount.Counter at any point.
 	MaxConcurrentLag *int32 `json:"maxConcurrentLag,omitempty"`
@@ -76,8 +298,26 @@ type AdmissionHistoryConfig struct {
 	CompactThreshold *int32 `json:"compactThreshold,omitempty"`
 	// Delay period between receiving pod event and aggregation.
 	AggregationRateMillis *int32 `json:"aggregationRateMillis,omitempty"`
+	// A cell with outstanding admission history is considered stale
+	// if its aggregation has not observed any reflector event for this number of milliseconds.
+	// Zero disables staleness detection.
+	StaleCellThresholdMillis *int32 `json:"staleCellThresholdMillis,omitempty"`
+	// How the available pods of stale cells are counted.
+	StaleCellPolicy *StaleCellPolicy `json:"staleCellPolicy,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=Zero;Estimated
+type StaleCellPolicy string
+
+const (
+	// Stale cells do not contribute any available pods.
+	StaleCellPolicyZero = StaleCellPolicy("Zero")
+	// Outstanding admissions in stale cells are counted as confirmed unavailability,
+	// i.e. stale cells only contribute their estimated available pods.
+	// EstimatedAvailable is unchanged; deletions that would otherwise be retried are denied instead.
+	StaleCellPolicyEstimated = StaleCellPolicy("Estimated")
+)
+
 type PodProtectorStatus struct {
 	// +listType=map
 	// +listMapKey=cellID
@@ -85,8 +325,51 @@ type PodProtectorStatus struct {
 	// Summary written by status-updating clients for display only,
 	// indicating the overall status.
 	Summary PodProtectorStatusSummary `json:"summary"`
+	// The metadata.generation of the PodProtector last observed by the aggregator.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Standard conditions maintained by the aggregator.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// Quota reserved by webhook replicas in leasing mode.
+	// Unexpired leases are deducted from the estimated availability.
+	// +optional
+	// +listType=map
+	// +listMapKey=id
+	Leases []PodProtectorQuotaLease `json:"leases,omitempty"`
 }
 
+// A chunk of disruption quota reserved by a webhook replica,
+// against which the replica admits deletions without updating the PodProtector.
+//
+// Deletions admitted against the lease are written as admission buckets when the lease is returned.
+type PodProtectorQuotaLease struct {
+	// Identifies the lease, prefixed by the identity of the webhook replica holding it.
+	Id string `json:"id"`
+	// Number of deletions the holder may admit against this lease.
+	// +kubebuilder:validation:Minimum=0
+	Quota int32 `json:"quota"`
+	// The holder stops admitting deletions against the lease well before this time.
+	// If the lease is not returned by then,
+	// it keeps reserving its quota until all cells have aggregated events no earlier than this time.
+	ExpiryTime metav1.MicroTime `json:"expiryTime"`
+}
+
+const (
+	// At least one cell has reported aggregation data.
+	ConditionTypeAggregated = "Aggregated"
+	// The aggregated number of available pods does not exceed the resolved minAvailable.
+	ConditionTypeAtRisk = "AtRisk"
+	// A cell with outstanding admission history has not observed any reflector event within the staleness threshold.
+	ConditionTypeStaleCell = "StaleCell"
+	// The admission history lags behind the slowest aggregator by more than the lag threshold.
+	ConditionTypeHistoryLagging = "HistoryLagging"
+	// Some windows in the spec are invalid and never take effect.
+	ConditionTypeInvalidWindow = "InvalidWindow"
+)
+
 type PodProtectorCellStatus struct {
 	// Identifies the group of pods managed by an aggregator instance.
 	CellId string `json:"cellID"`
@@ -123,6 +406,11 @@ type PodProtectorAggregation struct {
 
 type PodProtectorAdmissionHistory struct {
 	Buckets []PodProtectorAdmissionBucket `json:"buckets,omitempty"`
+	// Buckets already observed by the aggregator,
+	// retained until they leave the window of `spec.disruptionRate`.
+	// These buckets no longer affect the estimated availability.
+	// +optional
+	Observed []PodProtectorAdmissionBucket `json:"observed,omitempty"`
 }
 
 // Each bucket represents either one pod or the compacted set of old pods, allowed by webhook to delete.
@@ -135,15 +423,49 @@ type PodProtectorAdmissionBucket struct {
 	PodName string `json:"podName,omitempty"`
 	// The UID of the pod, if there is only one pod in this bucket.
 	PodUid *types.UID `json:"podUID,omitempty"`
+	// The identity of the user who requested the deletion, if there is only one pod in this bucket.
+	// This is either the username or a hash of it, depending on the webhook-deleter-identity plugin,
+	// and is empty if the plugin does not record identities.
+	// +optional
+	User string `json:"user,omitempty"`
+	// The kind of request that admitted the deletion, if there is only one pod in this bucket.
+	// This value is only set together with User.
+	// +optional
+	Operation PodProtectorAdmissionOperation `json:"operation,omitempty"`
 
 	// End time of this bucket, if this is a compacted bucket.
 	// +optional
 	EndTime *metav1.MicroTime `json:"endTime,omitempty"`
 	// Number of approved admission reviews within this bucket, if this is a compacted bucket.
 	Counter *int32 `json:"counter,omitempty"`
+	// Number of approved admission reviews per user within this bucket, if this is a compacted bucket.
+	// Admissions without a recorded user are not counted.
+	// +optional
+	Users []PodProtectorAdmissionUserCounter `json:"users,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Delete;Evict
+type PodProtectorAdmissionOperation string
+
+const (
+	// A DELETE request on the pod.
+	PodProtectorAdmissionOperationDelete = PodProtectorAdmissionOperation("Delete")
+	// A CREATE request on the pods/eviction subresource.
+	PodProtectorAdmissionOperationEvict = PodProtectorAdmissionOperation("Evict")
+)
+
+type PodProtectorAdmissionUserCounter struct {
+	// The identity of the user, in the same format as PodProtectorAdmissionBucket.User.
+	User string `json:"user"`
+	// Number of approved admission reviews requested by this user.
+	Counter int32 `json:"counter"`
 }
 
 type PodProtectorStatusSummary struct {
+	// Minimum number of available pods to ensure,
+	// resolved from MinAvailable and MaxUnavailable in the spec against Total.
+	// +optional
+	MinAvailable int32 `json:"minAvailable,omitempty"`
 	// Total number of non-terminating pods based on aggregation.
 	Total int32 `json:"totalReplicas"`
 	// Total number of available pods based on aggregation.
@@ -153,6 +475,13 @@ type PodProtectorStatusSummary struct {
 	MaxLatencyMillis int64 `json:"maxLatencyMillis,omitempty"`
 	// Estimated number of available pods after deducting admission history.
 	EstimatedAvailable int32 `json:"estimatedAvailableReplicas"`
+	// Number of cells counted with the stale cell policy.
+	// +optional
+	StaleCells int32 `json:"staleCells,omitempty"`
+	// Number of deletions reserved by unexpired quota leases,
+	// already deducted from EstimatedAvailable.
+	// +optional
+	Leased int32 `json:"leased,omitempty"`
 
 	// Total number of pods currently ready based on aggregation.
 	// This is equal to AvailableReplicas when MinReadySeconds is 0.
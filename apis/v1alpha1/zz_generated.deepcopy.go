@@ -23,6 +23,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	types "k8s.io/apimachinery/pkg/types"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -43,6 +44,16 @@ func (in *AdmissionHistoryConfig) DeepCopyInto(out *AdmissionHistoryConfig) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.StaleCellThresholdMillis != nil {
+		in, out := &in.StaleCellThresholdMillis, &out.StaleCellThresholdMillis
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StaleCellPolicy != nil {
+		in, out := &in.StaleCellPolicy, &out.StaleCellPolicy
+		*out = new(StaleCellPolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -56,6 +67,85 @@ func (in *AdmissionHistoryConfig) DeepCopy() *AdmissionHistoryConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPodProtector) DeepCopyInto(out *ClusterPodProtector) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPodProtector.
+func (in *ClusterPodProtector) DeepCopy() *ClusterPodProtector {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterPodProtector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterPodProtector) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPodProtectorList) DeepCopyInto(out *ClusterPodProtectorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterPodProtector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPodProtectorList.
+func (in *ClusterPodProtectorList) DeepCopy() *ClusterPodProtectorList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterPodProtectorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterPodProtectorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterPodProtectorSpec) DeepCopyInto(out *ClusterPodProtectorSpec) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	in.PodProtectorSpec.DeepCopyInto(&out.PodProtectorSpec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPodProtectorSpec.
+func (in *ClusterPodProtectorSpec) DeepCopy() *ClusterPodProtectorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterPodProtectorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodProtector) DeepCopyInto(out *PodProtector) {
 	*out = *in
@@ -102,6 +192,11 @@ func (in *PodProtectorAdmissionBucket) DeepCopyInto(out *PodProtectorAdmissionBu
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]PodProtectorAdmissionUserCounter, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -125,6 +220,13 @@ func (in *PodProtectorAdmissionHistory) DeepCopyInto(out *PodProtectorAdmissionH
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Observed != nil {
+		in, out := &in.Observed, &out.Observed
+		*out = make([]PodProtectorAdmissionBucket, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -138,6 +240,22 @@ func (in *PodProtectorAdmissionHistory) DeepCopy() *PodProtectorAdmissionHistory
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodProtectorAdmissionUserCounter) DeepCopyInto(out *PodProtectorAdmissionUserCounter) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodProtectorAdmissionUserCounter.
+func (in *PodProtectorAdmissionUserCounter) DeepCopy() *PodProtectorAdmissionUserCounter {
+	if in == nil {
+		return nil
+	}
+	out := new(PodProtectorAdmissionUserCounter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodProtectorAggregation) DeepCopyInto(out *PodProtectorAggregation) {
 	*out = *in
@@ -155,6 +273,27 @@ func (in *PodProtectorAggregation) DeepCopy() *PodProtectorAggregation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodProtectorCellConstraint) DeepCopyInto(out *PodProtectorCellConstraint) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodProtectorCellConstraint.
+func (in *PodProtectorCellConstraint) DeepCopy() *PodProtectorCellConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(PodProtectorCellConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodProtectorCellStatus) DeepCopyInto(out *PodProtectorCellStatus) {
 	*out = *in
@@ -173,6 +312,145 @@ func (in *PodProtectorCellStatus) DeepCopy() *PodProtectorCellStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodProtectorDisruptionRate) DeepCopyInto(out *PodProtectorDisruptionRate) {
+	*out = *in
+	out.Window = in.Window
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodProtectorDisruptionRate.
+func (in *PodProtectorDisruptionRate) DeepCopy() *PodProtectorDisruptionRate {
+	if in == nil {
+		return nil
+	}
+	out := new(PodProtectorDisruptionRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodProtectorGroup) DeepCopyInto(out *PodProtectorGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodProtectorGroup.
+func (in *PodProtectorGroup) DeepCopy() *PodProtectorGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(PodProtectorGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PodProtectorGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodProtectorGroupAdmission) DeepCopyInto(out *PodProtectorGroupAdmission) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodProtectorGroupAdmission.
+func (in *PodProtectorGroupAdmission) DeepCopy() *PodProtectorGroupAdmission {
+	if in == nil {
+		return nil
+	}
+	out := new(PodProtectorGroupAdmission)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodProtectorGroupList) DeepCopyInto(out *PodProtectorGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PodProtectorGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodProtectorGroupList.
+func (in *PodProtectorGroupList) DeepCopy() *PodProtectorGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(PodProtectorGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PodProtectorGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodProtectorGroupSpec) DeepCopyInto(out *PodProtectorGroupSpec) {
+	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodProtectorGroupSpec.
+func (in *PodProtectorGroupSpec) DeepCopy() *PodProtectorGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodProtectorGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodProtectorGroupStatus) DeepCopyInto(out *PodProtectorGroupStatus) {
+	*out = *in
+	if in.Admissions != nil {
+		in, out := &in.Admissions, &out.Admissions
+		*out = make([]PodProtectorGroupAdmission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodProtectorGroupStatus.
+func (in *PodProtectorGroupStatus) DeepCopy() *PodProtectorGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PodProtectorGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodProtectorList) DeepCopyInto(out *PodProtectorList) {
 	*out = *in
@@ -206,9 +484,31 @@ func (in *PodProtectorList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodProtectorQuotaLease) DeepCopyInto(out *PodProtectorQuotaLease) {
+	*out = *in
+	in.ExpiryTime.DeepCopyInto(&out.ExpiryTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodProtectorQuotaLease.
+func (in *PodProtectorQuotaLease) DeepCopy() *PodProtectorQuotaLease {
+	if in == nil {
+		return nil
+	}
+	out := new(PodProtectorQuotaLease)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodProtectorSpec) DeepCopyInto(out *PodProtectorSpec) {
 	*out = *in
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
 	in.Selector.DeepCopyInto(&out.Selector)
 	if in.AggregationSelector != nil {
 		in, out := &in.AggregationSelector, &out.AggregationSelector
@@ -216,6 +516,23 @@ func (in *PodProtectorSpec) DeepCopyInto(out *PodProtectorSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.AdmissionHistoryConfig.DeepCopyInto(&out.AdmissionHistoryConfig)
+	if in.CellConstraint != nil {
+		in, out := &in.CellConstraint, &out.CellConstraint
+		*out = new(PodProtectorCellConstraint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DisruptionRate != nil {
+		in, out := &in.DisruptionRate, &out.DisruptionRate
+		*out = new(PodProtectorDisruptionRate)
+		**out = **in
+	}
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]PodProtectorWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -240,6 +557,20 @@ func (in *PodProtectorStatus) DeepCopyInto(out *PodProtectorStatus) {
 		}
 	}
 	out.Summary = in.Summary
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Leases != nil {
+		in, out := &in.Leases, &out.Leases
+		*out = make([]PodProtectorQuotaLease, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -268,3 +599,37 @@ func (in *PodProtectorStatusSummary) DeepCopy() *PodProtectorStatusSummary {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodProtectorWindow) DeepCopyInto(out *PodProtectorWindow) {
+	*out = *in
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
+		*out = (*in).DeepCopy()
+	}
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodProtectorWindow.
+func (in *PodProtectorWindow) DeepCopy() *PodProtectorWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(PodProtectorWindow)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -27,6 +27,8 @@ import (
 
 type PodseidonV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	ClusterPodProtectorsGetter
+	PodProtectorGroupsGetter
 	PodProtectorsGetter
 }
 
@@ -35,6 +37,14 @@ type PodseidonV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *PodseidonV1alpha1Client) ClusterPodProtectors() ClusterPodProtectorInterface {
+	return newClusterPodProtectors(c)
+}
+
+func (c *PodseidonV1alpha1Client) PodProtectorGroups(namespace string) PodProtectorGroupInterface {
+	return newPodProtectorGroups(c, namespace)
+}
+
 func (c *PodseidonV1alpha1Client) PodProtectors(namespace string) PodProtectorInterface {
 	return newPodProtectors(c, namespace)
 }
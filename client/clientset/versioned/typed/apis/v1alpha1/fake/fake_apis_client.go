@@ -27,6 +27,14 @@ type FakePodseidonV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakePodseidonV1alpha1) ClusterPodProtectors() v1alpha1.ClusterPodProtectorInterface {
+	return newFakeClusterPodProtectors(c)
+}
+
+func (c *FakePodseidonV1alpha1) PodProtectorGroups(namespace string) v1alpha1.PodProtectorGroupInterface {
+	return newFakePodProtectorGroups(c, namespace)
+}
+
 func (c *FakePodseidonV1alpha1) PodProtectors(namespace string) v1alpha1.PodProtectorInterface {
 	return newFakePodProtectors(c, namespace)
 }
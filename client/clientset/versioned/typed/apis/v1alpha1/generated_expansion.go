@@ -16,4 +16,8 @@
 
 package v1alpha1
 
+type ClusterPodProtectorExpansion interface{}
+
 type PodProtectorExpansion interface{}
+
+type PodProtectorGroupExpansion interface{}
@@ -22,6 +22,10 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// ClusterPodProtectors returns a ClusterPodProtectorInformer.
+	ClusterPodProtectors() ClusterPodProtectorInformer
+	// PodProtectorGroups returns a PodProtectorGroupInformer.
+	PodProtectorGroups() PodProtectorGroupInformer
 	// PodProtectors returns a PodProtectorInformer.
 	PodProtectors() PodProtectorInformer
 }
@@ -37,6 +41,16 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// ClusterPodProtectors returns a ClusterPodProtectorInformer.
+func (v *version) ClusterPodProtectors() ClusterPodProtectorInformer {
+	return &clusterPodProtectorInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// PodProtectorGroups returns a PodProtectorGroupInformer.
+func (v *version) PodProtectorGroups() PodProtectorGroupInformer {
+	return &podProtectorGroupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // PodProtectors returns a PodProtectorInformer.
 func (v *version) PodProtectors() PodProtectorInformer {
 	return &podProtectorInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
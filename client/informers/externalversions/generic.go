@@ -52,6 +52,10 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=podseidon.kubewharf.io, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithResource("clusterpodprotectors"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Podseidon().V1alpha1().ClusterPodProtectors().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("podprotectorgroups"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Podseidon().V1alpha1().PodProtectorGroups().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("podprotectors"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Podseidon().V1alpha1().PodProtectors().Informer()}, nil
 	}
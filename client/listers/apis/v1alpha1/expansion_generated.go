@@ -16,6 +16,10 @@
 
 package v1alpha1
 
+// ClusterPodProtectorListerExpansion allows custom methods to be added to
+// ClusterPodProtectorLister.
+type ClusterPodProtectorListerExpansion interface{}
+
 // PodProtectorListerExpansion allows custom methods to be added to
 // PodProtectorLister.
 type PodProtectorListerExpansion interface{}
@@ -23,3 +27,11 @@ type PodProtectorListerExpansion interface{}
 // PodProtectorNamespaceListerExpansion allows custom methods to be added to
 // PodProtectorNamespaceLister.
 type PodProtectorNamespaceListerExpansion interface{}
+
+// PodProtectorGroupListerExpansion allows custom methods to be added to
+// PodProtectorGroupLister.
+type PodProtectorGroupListerExpansion interface{}
+
+// PodProtectorGroupNamespaceListerExpansion allows custom methods to be added to
+// PodProtectorGroupNamespaceLister.
+type PodProtectorGroupNamespaceListerExpansion interface{}
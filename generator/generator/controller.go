@@ -76,6 +76,11 @@ var NewController = component.Declare(
 			typeProviders[i] = component.DepPtr(requests, ty)
 		}
 
+		typeSets := make([]component.Dep[[]resource.TypeProvider], len(args.TypeSets))
+		for i, tySet := range args.TypeSets {
+			typeSets[i] = component.DepPtr(requests, tySet)
+		}
+
 		return ControllerDeps{
 			cluster: component.DepPtr(requests, kube.NewClient(kube.ClientArgs{
 				ClusterName: constants.CoreClusterName,
@@ -91,7 +96,8 @@ var NewController = component.Declare(
 				"generator",
 				clock.RealClock{},
 			)),
-			types: typeProviders,
+			types:    typeProviders,
+			typeSets: typeSets,
 		}
 	},
 	func(_ context.Context, _ ControllerArgs, _ ControllerOptions, deps ControllerDeps) (*ControllerState, error) {
@@ -105,18 +111,23 @@ var NewController = component.Declare(
 			return nil, errors.TagWrapf("AddIndexers", err, "add pod indexer to ppr informer")
 		}
 
+		typeProviders := util.MapSlice(deps.types, component.Dep[resource.TypeProvider].Get)
+		for _, tySet := range deps.typeSets {
+			typeProviders = append(typeProviders, tySet.Get()...)
+		}
+
 		prereqs := map[string]worker.Prereq{
 			"ppr-informer-synced": worker.InformerPrereq(pprInformer.Informer()),
 		}
-		for _, ty := range deps.types {
-			ty.Get().AddPrereqs(prereqs)
+		for _, ty := range typeProviders {
+			ty.AddPrereqs(prereqs)
 		}
 
 		queue.SetExecutor(
 			func(ctx context.Context, item QueueKey) error {
 				return ReconcileItem(
 					ctx,
-					util.MapSlice(deps.types, component.Dep[resource.TypeProvider].Get),
+					typeProviders,
 					deps.observer.Get(),
 					deps.cluster.Get().PodseidonClientSet().PodseidonV1alpha1(),
 					pprInformer.Informer().GetIndexer(),
@@ -127,8 +138,8 @@ var NewController = component.Declare(
 		)
 		queue.SetBeforeStart(deps.elector.Get().Await)
 
-		for index, ty := range deps.types {
-			err := ty.Get().AddEventHandler(func(name types.NamespacedName) {
+		for index, ty := range typeProviders {
+			err := ty.AddEventHandler(func(name types.NamespacedName) {
 				deps.worker.Get().Enqueue(QueueKey{
 					NsName:       name,
 					TypeDefIndex: index,
@@ -157,6 +168,9 @@ var NewController = component.Declare(
 
 type ControllerArgs struct {
 	Types []component.Declared[resource.TypeProvider]
+	// Plugins that provide a list of types only known after option parsing,
+	// e.g. types declared from a configuration file.
+	TypeSets []component.Declared[[]resource.TypeProvider]
 }
 
 type ControllerOptions struct{}
@@ -168,7 +182,8 @@ type ControllerDeps struct {
 	observer           component.Dep[observer.Observer]
 	worker             component.Dep[worker.Api[QueueKey]]
 
-	types []component.Dep[resource.TypeProvider]
+	types    []component.Dep[resource.TypeProvider]
+	typeSets []component.Dep[[]resource.TypeProvider]
 }
 
 type ControllerState struct{}
@@ -484,10 +499,24 @@ func syncProtector(
 		return errors.TagWrapf("GeneratePpr", err, "generating PodProtector from source object")
 	}
 
-	if hasChange || !reflect.DeepEqual(current.Spec, expected.Spec) {
+	groupLabelChanged := current.Labels[podseidon.PprGroupLabel] != expected.Labels[podseidon.PprGroupLabel]
+
+	if hasChange || groupLabelChanged || !reflect.DeepEqual(current.Spec, expected.Spec) {
 		next := current.DeepCopy()
 		next.Spec = expected.Spec
 
+		if groupLabelChanged {
+			if groupName, hasGroup := expected.Labels[podseidon.PprGroupLabel]; hasGroup {
+				if next.Labels == nil {
+					next.Labels = map[string]string{}
+				}
+
+				next.Labels[podseidon.PprGroupLabel] = groupName
+			} else {
+				delete(next.Labels, podseidon.PprGroupLabel)
+			}
+		}
+
 		_, err := pprClient.
 			PodProtectors(next.Namespace).
 			Update(ctx, next, metav1.UpdateOptions{})
@@ -18,8 +18,9 @@ import (
 	"github.com/kubewharf/podseidon/util/cmd"
 	"github.com/kubewharf/podseidon/util/component"
 	healthzobserver "github.com/kubewharf/podseidon/util/healthz/observer"
+	httpobserver "github.com/kubewharf/podseidon/util/http/observer"
 	kubeobserver "github.com/kubewharf/podseidon/util/kube/observer"
-	"github.com/kubewharf/podseidon/util/o11y/metrics"
+	metricshttp "github.com/kubewharf/podseidon/util/o11y/metrics/http"
 	pprutilobserver "github.com/kubewharf/podseidon/util/podprotector/observer"
 	"github.com/kubewharf/podseidon/util/pprof"
 	"github.com/kubewharf/podseidon/util/util"
@@ -29,14 +30,20 @@ import (
 	"github.com/kubewharf/podseidon/generator/monitor"
 	generatorobserver "github.com/kubewharf/podseidon/generator/observer"
 	"github.com/kubewharf/podseidon/generator/resource"
+	"github.com/kubewharf/podseidon/generator/resource/daemonset"
 	"github.com/kubewharf/podseidon/generator/resource/deployment"
+	"github.com/kubewharf/podseidon/generator/resource/generic"
+	"github.com/kubewharf/podseidon/generator/resource/pdb"
+	"github.com/kubewharf/podseidon/generator/resource/statefulset"
+	"github.com/kubewharf/podseidon/generator/shadowpdb"
 )
 
 func main() {
 	cmd.Run(
 		component.RequireDep(pprof.New(util.Empty{})),
-		component.RequireDep(metrics.NewHttp(metrics.HttpArgs{})),
+		component.RequireDep(metricshttp.New(metricshttp.Args{})),
 		healthzobserver.Provide,
+		httpobserver.Provide,
 		workerobserver.Provide,
 		kubeobserver.ProvideElector,
 		pprutilobserver.ProvideInformer,
@@ -45,9 +52,16 @@ func main() {
 			generator.ControllerArgs{
 				Types: []component.Declared[resource.TypeProvider]{
 					deployment.New(util.Empty{}),
+					statefulset.New(util.Empty{}),
+					daemonset.New(util.Empty{}),
+					pdb.New(util.Empty{}),
+				},
+				TypeSets: []component.Declared[[]resource.TypeProvider]{
+					generic.New(util.Empty{}),
 				},
 			},
 		)),
 		component.RequireDep(monitor.New(monitor.Args{})),
+		component.RequireDep(shadowpdb.New(shadowpdb.Args{})),
 	)
 }
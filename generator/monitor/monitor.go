@@ -30,6 +30,7 @@ import (
 	"github.com/kubewharf/podseidon/util/kube"
 	"github.com/kubewharf/podseidon/util/o11y"
 	"github.com/kubewharf/podseidon/util/optional"
+	pprutil "github.com/kubewharf/podseidon/util/podprotector"
 	"github.com/kubewharf/podseidon/util/util"
 
 	"github.com/kubewharf/podseidon/generator/constants"
@@ -129,22 +130,24 @@ type State struct {
 }
 
 func pprToStatus(ppr *podseidonv1a1.PodProtector) observer.MonitorWorkloads {
+	minAvailable := pprutil.ResolveMinAvailable(ppr.Spec, ppr.Status.Summary.Total)
+
 	isNonZero := 0
-	if ppr.Spec.MinAvailable > 0 {
+	if minAvailable > 0 {
 		isNonZero = 1
 	}
 
 	return observer.MonitorWorkloads{
 		NumWorkloads:               1,
 		NumNonZeroWorkloads:        isNonZero,
-		MinAvailable:               int64(ppr.Spec.MinAvailable),
+		MinAvailable:               int64(minAvailable),
 		EstimatedAvailableReplicas: int64(ppr.Status.Summary.EstimatedAvailable),
 		SumLatencyMillis:           ppr.Status.Summary.MaxLatencyMillis,
-		Created:                    makeStatusCount(ppr.Status.Summary.Total, ppr.Spec.MinAvailable),
-		Available:                  makeStatusCount(ppr.Status.Summary.AggregatedAvailable, ppr.Spec.MinAvailable),
-		Ready:                      makeStatusCount(ppr.Status.Summary.AggregatedReady, ppr.Spec.MinAvailable),
-		Scheduled:                  makeStatusCount(ppr.Status.Summary.AggregatedScheduled, ppr.Spec.MinAvailable),
-		Running:                    makeStatusCount(ppr.Status.Summary.AggregatedRunning, ppr.Spec.MinAvailable),
+		Created:                    makeStatusCount(ppr.Status.Summary.Total, minAvailable),
+		Available:                  makeStatusCount(ppr.Status.Summary.AggregatedAvailable, minAvailable),
+		Ready:                      makeStatusCount(ppr.Status.Summary.AggregatedReady, minAvailable),
+		Scheduled:                  makeStatusCount(ppr.Status.Summary.AggregatedScheduled, minAvailable),
+		Running:                    makeStatusCount(ppr.Status.Summary.AggregatedRunning, minAvailable),
 	}
 }
 
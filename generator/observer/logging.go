@@ -112,6 +112,24 @@ func ProvideLogging() component.Declared[Observer] {
 						Info("No PodProtectors present, ensuring finalizer removal from source object")
 					return ctx, util.NoOp
 				},
+				SyncShadowPdb: func(ctx context.Context, arg SyncShadowPdb) {
+					logger := klog.FromContext(ctx).WithValues(
+						"namespace", arg.Namespace,
+						"pprName", arg.PprName,
+						"action", arg.Action,
+					)
+
+					switch {
+					case arg.Err != nil:
+						logger.WithCallDepth(1).Error(arg.Err, "sync shadow PodDisruptionBudget")
+					case arg.Action == ShadowPdbActionConflict:
+						logger.WithCallDepth(1).Info("PodDisruptionBudget with the shadow name is not managed by generator")
+					case arg.Action == ShadowPdbActionOverlap:
+						logger.WithCallDepth(1).Info("PodProtector is not mirrored because its pods may be selected by an existing PodDisruptionBudget")
+					default:
+						logger.V(4).WithCallDepth(1).Info("sync shadow PodDisruptionBudget")
+					}
+				},
 				MonitorWorkloads: func(context.Context, util.Empty, func() MonitorWorkloads) {},
 			}
 		},
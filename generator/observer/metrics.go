@@ -75,6 +75,19 @@ func ProvideMetrics() component.Declared[Observer] {
 				})
 			}
 
+			type shadowPdbTags struct {
+				Action string
+				Error  string
+			}
+
+			shadowPdbHandle := metrics.Register(
+				deps.Registry(),
+				"generator_shadow_pdb_sync",
+				"Number of shadow PodDisruptionBudget reconcile runs by action.",
+				metrics.IntCounter(),
+				metrics.NewReflectTags[shadowPdbTags](),
+			)
+
 			monitorWorkloadsHandle := makeMonitorWorkloadsHandle(deps.Registry())
 
 			return Observer{
@@ -129,6 +142,12 @@ func ProvideMetrics() component.Declared[Observer] {
 					})
 					return ctx, util.NoOp
 				},
+				SyncShadowPdb: func(_ context.Context, arg SyncShadowPdb) {
+					shadowPdbHandle.Emit(1, shadowPdbTags{
+						Action: string(arg.Action),
+						Error:  errors.SerializeTags(arg.Err),
+					})
+				},
 				MonitorWorkloads: func(ctx context.Context, _ util.Empty, getter func() MonitorWorkloads) {
 					metrics.Repeating(ctx, deps, monitorWorkloadsHandle.With(util.Empty{}), getter)
 				},
@@ -43,6 +43,8 @@ type Observer struct {
 	DeleteProtector      o11y.ObserveScopeFunc[*podseidonv1a1.PodProtector]
 	CleanSourceFinalizer o11y.ObserveScopeFunc[StartReconcile]
 
+	SyncShadowPdb o11y.ObserveFunc[SyncShadowPdb]
+
 	MonitorWorkloads o11y.MonitorFunc[util.Empty, MonitorWorkloads]
 }
 
@@ -91,6 +93,25 @@ const (
 	ActionError               Action = "Error"
 )
 
+type SyncShadowPdb struct {
+	Namespace string
+	PprName   string
+	Action    ShadowPdbAction
+	Err       error
+}
+
+type ShadowPdbAction string
+
+const (
+	ShadowPdbActionNoop     ShadowPdbAction = "Noop"
+	ShadowPdbActionCreate   ShadowPdbAction = "Create"
+	ShadowPdbActionUpdate   ShadowPdbAction = "Update"
+	ShadowPdbActionDelete   ShadowPdbAction = "Delete"
+	ShadowPdbActionConflict ShadowPdbAction = "Conflict"
+	ShadowPdbActionOverlap  ShadowPdbAction = "Overlap"
+	ShadowPdbActionError    ShadowPdbAction = "Error"
+)
+
 // Note about data types:
 // number of pods => int64, max value = max(deployment.spec.replicas) * {number of pprs}
 // number of pprs => int, delegated from len(pprs)
@@ -0,0 +1,416 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/util/jsonpath"
+
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
+
+	"github.com/kubewharf/podseidon/util/errors"
+	"github.com/kubewharf/podseidon/util/optional"
+)
+
+// Declares how objects of a workload type are interpreted into a PodProtector.
+//
+// All expression fields are JSONPath expressions evaluated against the unstructured object,
+// e.g. `.spec.replicas` or `{.spec.selector}`.
+// Expressions must resolve to at most one value.
+type TypeConfig struct {
+	Group    string `json:"group"`
+	Version  string `json:"version"`
+	Resource string `json:"resource"`
+	Kind     string `json:"kind"`
+
+	// Prefix of generated PodProtector names, followed by `-` and the workload name.
+	// Defaults to the lowercase kind.
+	NamePrefix string `json:"namePrefix,omitempty"`
+
+	// Only enable protection for objects matching this label selector.
+	// Empty string matches everything.
+	ProtectionSelector string `json:"protectionSelector,omitempty"`
+
+	// Prevent cascade deletion when the workload has non-zero replicas.
+	ProtectNonZero bool `json:"protectNonZero,omitempty"`
+
+	// Required. Resolves to a metav1.LabelSelector object selecting the pods of the workload.
+	Selector string `json:"selector"`
+
+	// Required. Resolves to the desired number of replicas.
+	// Defaults to 1 if the field is absent in the object.
+	Replicas string `json:"replicas"`
+
+	// Resolves to an integer or percentage of unavailable replicas tolerated.
+	// Percentages are rounded up.
+	MaxUnavailable string `json:"maxUnavailable,omitempty"`
+
+	// The fallback value if MaxUnavailable is unspecified or absent in the object.
+	// Defaults to 25%, consistent with deployment-controller.
+	DefaultMaxUnavailable *intstr.IntOrString `json:"defaultMaxUnavailable,omitempty"`
+
+	// Resolves to the minReadySeconds of the workload.
+	// Defaults to 0 if unspecified or absent in the object.
+	MinReadySeconds string `json:"minReadySeconds,omitempty"`
+}
+
+func (config *TypeConfig) GroupVersionResource() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: config.Group, Version: config.Version, Resource: config.Resource}
+}
+
+// A TypeConfig with validated fields and parsed expressions.
+type CompiledType struct {
+	gvr                   schema.GroupVersionResource
+	gvk                   schema.GroupVersionKind
+	namePrefix            string
+	protectionSelector    labels.Selector
+	protectNonZero        bool
+	selector              jsonPathExpr
+	replicas              jsonPathExpr
+	maxUnavailable        optional.Optional[jsonPathExpr]
+	defaultMaxUnavailable intstr.IntOrString
+	minReadySeconds       optional.Optional[jsonPathExpr]
+}
+
+// A validated JSONPath expression.
+//
+// The expression is parsed again for every evaluation
+// because jsonpath.JSONPath mutates its state during evaluation
+// and is not safe for concurrent use by generator workers.
+type jsonPathExpr struct {
+	fieldName string
+	expr      string
+}
+
+func (expr jsonPathExpr) parse() (*jsonpath.JSONPath, error) {
+	parsed := jsonpath.New(expr.fieldName).AllowMissingKeys(true)
+	if err := parsed.Parse(expr.expr); err != nil {
+		return nil, errors.TagWrapf("ParseJsonPath", err, "parse JSONPath for %s", expr.fieldName)
+	}
+
+	return parsed, nil
+}
+
+func (config *TypeConfig) Compile() (*CompiledType, error) {
+	for fieldName, value := range map[string]string{
+		"version":  config.Version,
+		"resource": config.Resource,
+		"kind":     config.Kind,
+		"selector": config.Selector,
+		"replicas": config.Replicas,
+	} {
+		if value == "" {
+			return nil, errors.TagErrorf("MissingField", "field %q is required", fieldName)
+		}
+	}
+
+	namePrefix := config.NamePrefix
+	if namePrefix == "" {
+		namePrefix = strings.ToLower(config.Kind)
+	}
+
+	protectionSelector, err := labels.Parse(config.ProtectionSelector)
+	if err != nil {
+		return nil, errors.TagWrapf("ParseProtectionSelector", err, "parse protectionSelector")
+	}
+
+	selector, err := compileExpr("selector", config.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	replicas, err := compileExpr("replicas", config.Replicas)
+	if err != nil {
+		return nil, err
+	}
+
+	maxUnavailable, err := compileOptionalExpr("maxUnavailable", config.MaxUnavailable)
+	if err != nil {
+		return nil, err
+	}
+
+	minReadySeconds, err := compileOptionalExpr("minReadySeconds", config.MinReadySeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultMaxUnavailable := intstr.FromString("25%")
+	if config.DefaultMaxUnavailable != nil {
+		defaultMaxUnavailable = *config.DefaultMaxUnavailable
+	}
+
+	return &CompiledType{
+		gvr:                   config.GroupVersionResource(),
+		gvk:                   schema.GroupVersionKind{Group: config.Group, Version: config.Version, Kind: config.Kind},
+		namePrefix:            namePrefix,
+		protectionSelector:    protectionSelector,
+		protectNonZero:        config.ProtectNonZero,
+		selector:              selector,
+		replicas:              replicas,
+		maxUnavailable:        maxUnavailable,
+		defaultMaxUnavailable: defaultMaxUnavailable,
+		minReadySeconds:       minReadySeconds,
+	}, nil
+}
+
+func compileExpr(fieldName string, expr string) (_zero jsonPathExpr, _ error) {
+	if !strings.HasPrefix(expr, "{") {
+		expr = fmt.Sprintf("{%s}", expr)
+	}
+
+	compiled := jsonPathExpr{fieldName: fieldName, expr: expr}
+	if _, err := compiled.parse(); err != nil {
+		return _zero, err
+	}
+
+	return compiled, nil
+}
+
+func compileOptionalExpr(fieldName string, expr string) (optional.Optional[jsonPathExpr], error) {
+	if expr == "" {
+		return optional.None[jsonPathExpr](), nil
+	}
+
+	compiled, err := compileExpr(fieldName, expr)
+	if err != nil {
+		return optional.None[jsonPathExpr](), err
+	}
+
+	return optional.Some(compiled), nil
+}
+
+func (ty *CompiledType) GroupVersionResource() schema.GroupVersionResource {
+	return ty.gvr
+}
+
+func (ty *CompiledType) GroupVersionKind() schema.GroupVersionKind {
+	return ty.gvk
+}
+
+// Returns the name of the PodProtector generated for the given workload name.
+func (ty *CompiledType) ProtectorName(workloadName string) string {
+	return fmt.Sprintf("%s-%s", ty.namePrefix, workloadName)
+}
+
+// Evaluates the desired number of replicas of the object.
+func (ty *CompiledType) Replicas(obj *unstructured.Unstructured) (int32, error) {
+	value, err := evalSingle(ty.replicas, obj)
+	if err != nil {
+		return 0, err
+	}
+
+	rawValue, isSome := value.Get()
+	if !isSome {
+		return 1, nil
+	}
+
+	return toInt32("replicas", rawValue)
+}
+
+// Computes the PodProtector spec required by the object.
+func (ty *CompiledType) Spec(obj *unstructured.Unstructured) (_zero podseidonv1a1.PodProtectorSpec, _ error) {
+	totalReplicas, err := ty.Replicas(obj)
+	if err != nil {
+		return _zero, err
+	}
+
+	selector, err := ty.evalSelector(obj)
+	if err != nil {
+		return _zero, err
+	}
+
+	maxUnavailableIs, err := ty.evalMaxUnavailable(obj)
+	if err != nil {
+		return _zero, err
+	}
+
+	maxUnavailableInt, err := intstr.GetScaledValueFromIntOrPercent(&maxUnavailableIs, int(totalReplicas), true)
+	if err != nil {
+		return _zero, errors.TagWrapf("ParseMaxUnavailable", err, "parse max unavailable from %s", ty.gvk.Kind)
+	}
+
+	if maxUnavailableInt > math.MaxInt32 {
+		return _zero, errors.TagErrorf(
+			"TooManyReplicas",
+			"maxUnavailable overflows after resolution",
+		)
+	}
+
+	minReadySeconds := int32(0)
+
+	if expr, isSome := ty.minReadySeconds.Get(); isSome {
+		value, err := evalSingle(expr, obj)
+		if err != nil {
+			return _zero, err
+		}
+
+		if value, isSome := value.Get(); isSome {
+			minReadySeconds, err = toInt32("minReadySeconds", value)
+			if err != nil {
+				return _zero, err
+			}
+		}
+	}
+
+	// #nosec G115 -- overflow has been checked
+	requiredReplicas := totalReplicas - int32(maxUnavailableInt)
+
+	return podseidonv1a1.PodProtectorSpec{
+		MinAvailable:    max(requiredReplicas, 0),
+		MinReadySeconds: minReadySeconds,
+		Selector:        selector,
+	}, nil
+}
+
+func (ty *CompiledType) evalSelector(obj *unstructured.Unstructured) (_zero metav1.LabelSelector, _ error) {
+	value, err := evalSingle(ty.selector, obj)
+	if err != nil {
+		return _zero, err
+	}
+
+	rawValue, isSome := value.Get()
+	if !isSome {
+		return _zero, errors.TagErrorf("MissingSelector", "selector is absent in object")
+	}
+
+	rawMap, isMap := rawValue.(map[string]any)
+	if !isMap {
+		return _zero, errors.TagErrorf("SelectorNotObject", "selector must resolve to an object, got %T", rawValue)
+	}
+
+	var selector metav1.LabelSelector
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(rawMap, &selector); err != nil {
+		return _zero, errors.TagWrapf("ParseSelector", err, "parse selector as LabelSelector")
+	}
+
+	return selector, nil
+}
+
+func (ty *CompiledType) evalMaxUnavailable(obj *unstructured.Unstructured) (intstr.IntOrString, error) {
+	expr, isSome := ty.maxUnavailable.Get()
+	if !isSome {
+		return ty.defaultMaxUnavailable, nil
+	}
+
+	value, err := evalSingle(expr, obj)
+	if err != nil {
+		return ty.defaultMaxUnavailable, err
+	}
+
+	rawValue, isSome := value.Get()
+	if !isSome {
+		return ty.defaultMaxUnavailable, nil
+	}
+
+	if str, isString := rawValue.(string); isString {
+		return intstr.FromString(str), nil
+	}
+
+	intValue, err := toInt32("maxUnavailable", rawValue)
+	if err != nil {
+		return ty.defaultMaxUnavailable, err
+	}
+
+	return intstr.FromInt32(intValue), nil
+}
+
+// Evaluates a JSONPath expression that is expected to resolve to zero or one values.
+func evalSingle(expr jsonPathExpr, obj *unstructured.Unstructured) (optional.Optional[any], error) {
+	parsed, err := expr.parse()
+	if err != nil {
+		return optional.None[any](), err
+	}
+
+	results, err := parsed.FindResults(obj.Object)
+	if err != nil {
+		return optional.None[any](), errors.TagWrapf("EvalJsonPath", err, "evaluate JSONPath")
+	}
+
+	values := []any{}
+
+	for _, result := range results {
+		for _, value := range result {
+			if value.IsValid() && value.CanInterface() {
+				values = append(values, value.Interface())
+			}
+		}
+	}
+
+	switch len(values) {
+	case 0:
+		return optional.None[any](), nil
+	case 1:
+		if values[0] == nil {
+			return optional.None[any](), nil
+		}
+
+		return optional.Some(values[0]), nil
+	default:
+		return optional.None[any](), errors.TagErrorf(
+			"MultipleJsonPathResults",
+			"JSONPath resolved to %d values, expected at most one",
+			len(values),
+		)
+	}
+}
+
+func toInt32(fieldName string, value any) (int32, error) {
+	var intValue int64
+
+	switch value := value.(type) {
+	case int64:
+		intValue = value
+	case int32:
+		intValue = int64(value)
+	case int:
+		intValue = int64(value)
+	case float64:
+		if value != math.Trunc(value) {
+			return 0, errors.TagErrorf("NonIntegerValue", "%s must be an integer, got %v", fieldName, value)
+		}
+
+		intValue = int64(value)
+	default:
+		return 0, errors.TagErrorf("NonIntegerValue", "%s must be an integer, got %T", fieldName, value)
+	}
+
+	if intValue < math.MinInt32 || intValue > math.MaxInt32 {
+		return 0, errors.TagErrorf("IntegerOverflow", "%s overflows int32", fieldName)
+	}
+
+	// #nosec G115 -- overflow has been checked
+	return int32(intValue), nil
+}
+
+type defaultReqmt struct {
+	obj *sourceObject
+}
+
+func (reqmt *defaultReqmt) Name() string {
+	return reqmt.obj.ty.ProtectorName(reqmt.obj.GetName())
+}
+
+func (reqmt *defaultReqmt) Spec() (podseidonv1a1.PodProtectorSpec, error) {
+	return reqmt.obj.ty.Spec(reqmt.obj.Unstructured)
+}
@@ -0,0 +1,168 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/kubewharf/podseidon/generator/resource/generic"
+)
+
+//nolint:exhaustruct
+func rolloutConfig() generic.TypeConfig {
+	return generic.TypeConfig{
+		Group:           "argoproj.io",
+		Version:         "v1alpha1",
+		Resource:        "rollouts",
+		Kind:            "Rollout",
+		Selector:        ".spec.selector",
+		Replicas:        ".spec.replicas",
+		MaxUnavailable:  ".spec.strategy.canary.maxUnavailable",
+		MinReadySeconds: ".spec.minReadySeconds",
+	}
+}
+
+func rolloutObject(spec map[string]any) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "argoproj.io/v1alpha1",
+		"kind":       "Rollout",
+		"metadata": map[string]any{
+			"namespace": "default",
+			"name":      "foo",
+		},
+		"spec": spec,
+	}}
+}
+
+func TestSpecIntMaxUnavailable(t *testing.T) {
+	t.Parallel()
+
+	config := rolloutConfig()
+	compiled, err := config.Compile()
+	require.NoError(t, err)
+
+	assert.Equal(t, "rollout-foo", compiled.ProtectorName("foo"))
+
+	spec, err := compiled.Spec(rolloutObject(map[string]any{
+		"replicas":        int64(10),
+		"minReadySeconds": int64(5),
+		"selector": map[string]any{
+			"matchLabels": map[string]any{"app": "foo"},
+		},
+		"strategy": map[string]any{
+			"canary": map[string]any{"maxUnavailable": int64(3)},
+		},
+	}))
+	require.NoError(t, err)
+
+	assert.Equal(t, int32(7), spec.MinAvailable)
+	assert.Equal(t, int32(5), spec.MinReadySeconds)
+	assert.Equal(t, metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, spec.Selector) //nolint:exhaustruct
+}
+
+func TestSpecPercentMaxUnavailable(t *testing.T) {
+	t.Parallel()
+
+	config := rolloutConfig()
+	compiled, err := config.Compile()
+	require.NoError(t, err)
+
+	spec, err := compiled.Spec(rolloutObject(map[string]any{
+		"replicas": int64(10),
+		"selector": map[string]any{},
+		"strategy": map[string]any{
+			"canary": map[string]any{"maxUnavailable": "15%"},
+		},
+	}))
+	require.NoError(t, err)
+
+	// percentage is rounded up
+	assert.Equal(t, int32(8), spec.MinAvailable)
+	assert.Equal(t, int32(0), spec.MinReadySeconds)
+}
+
+func TestSpecDefaults(t *testing.T) {
+	t.Parallel()
+
+	config := rolloutConfig()
+	compiled, err := config.Compile()
+	require.NoError(t, err)
+
+	spec, err := compiled.Spec(rolloutObject(map[string]any{
+		"selector": map[string]any{},
+	}))
+	require.NoError(t, err)
+
+	// replicas defaults to 1, maxUnavailable defaults to 25% rounded up
+	assert.Equal(t, int32(0), spec.MinAvailable)
+}
+
+func TestSpecMissingSelector(t *testing.T) {
+	t.Parallel()
+
+	config := rolloutConfig()
+	compiled, err := config.Compile()
+	require.NoError(t, err)
+
+	_, err = compiled.Spec(rolloutObject(map[string]any{
+		"replicas": int64(3),
+	}))
+	require.Error(t, err)
+}
+
+func TestSpecConcurrentRange(t *testing.T) {
+	t.Parallel()
+
+	config := rolloutConfig()
+	config.Replicas = "{range .spec.replicaSets[*]}{.replicas}{end}"
+	compiled, err := config.Compile()
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+
+	for i := range 16 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for range 100 {
+				replicas, err := compiled.Replicas(rolloutObject(map[string]any{
+					"replicaSets": []any{map[string]any{"replicas": int64(i)}},
+				}))
+				assert.NoError(t, err)
+				assert.Equal(t, int32(i), replicas)
+			}
+		}()
+	}
+
+	wg.Wait()
+}
+
+func TestCompileMissingField(t *testing.T) {
+	t.Parallel()
+
+	config := rolloutConfig()
+	config.Replicas = ""
+
+	_, err := config.Compile()
+	require.Error(t, err)
+}
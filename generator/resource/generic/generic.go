@@ -0,0 +1,273 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Interprets arbitrary workload types (e.g. third-party CRDs) into PodProtectors
+// using JSONPath expressions declared in configuration.
+package generic
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/informers"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubewharf/podseidon/util/component"
+	"github.com/kubewharf/podseidon/util/errors"
+	"github.com/kubewharf/podseidon/util/kube"
+	"github.com/kubewharf/podseidon/util/o11y"
+	"github.com/kubewharf/podseidon/util/optional"
+	"github.com/kubewharf/podseidon/util/util"
+	"github.com/kubewharf/podseidon/util/worker"
+
+	"github.com/kubewharf/podseidon/generator/constants"
+	"github.com/kubewharf/podseidon/generator/observer"
+	"github.com/kubewharf/podseidon/generator/resource"
+)
+
+var New = component.Declare(
+	func(util.Empty) string { return "generic-plugin" },
+	func(_ util.Empty, fs *flag.FlagSet) Options {
+		return Options{
+			types: fs.String(
+				"types",
+				"",
+				"JSON/YAML list of workload types to interpret, in the same format as --generic-plugin-config-file",
+			),
+			configFile: fs.String(
+				"config-file",
+				"",
+				"path to a JSON/YAML file containing a list of workload types to interpret",
+			),
+		}
+	},
+	func(_ util.Empty, requests *component.DepRequests) Deps {
+		return Deps{
+			observer: o11y.Request[observer.Observer](requests),
+			client: component.DepPtr(requests, kube.NewClient(kube.ClientArgs{
+				ClusterName: constants.CoreClusterName,
+			})),
+			informers: component.DepPtr(requests, kube.NewInformers(kube.DynamicInformers(
+				constants.CoreClusterName,
+				constants.LeaderPhase,
+				optional.Some(constants.GeneratorElectorArgs),
+			))),
+		}
+	},
+	func(_ context.Context, _ util.Empty, options Options, deps Deps) (*State, error) {
+		configs, err := loadConfigs(*options.types, *options.configFile)
+		if err != nil {
+			return nil, err
+		}
+
+		providers := make([]resource.TypeProvider, 0, len(configs))
+		seenGvrs := sets.New[schema.GroupVersionResource]()
+
+		for _, config := range configs {
+			compiled, err := config.Compile()
+			if err != nil {
+				return nil, errors.TagWrapf("CompileTypeConfig", err, "compile config for %s", config.GroupVersionResource())
+			}
+
+			if seenGvrs.Has(compiled.gvr) {
+				return nil, errors.TagErrorf("DuplicateType", "resource %s is declared multiple times", compiled.gvr)
+			}
+
+			seenGvrs.Insert(compiled.gvr)
+
+			providers = append(providers, &TypeProvider{
+				CompiledType: compiled,
+				observer:     deps.observer.Get(),
+				client:       deps.client.Get().DynamicClient().Resource(compiled.gvr),
+				informer:     deps.informers.Get().Factory.ForResource(compiled.gvr),
+			})
+		}
+
+		return &State{providers: providers}, nil
+	},
+	component.Lifecycle[util.Empty, Options, Deps, State]{
+		Start:        nil,
+		Join:         nil,
+		HealthChecks: nil,
+	},
+	func(data *component.Data[util.Empty, Options, Deps, State]) []resource.TypeProvider {
+		return data.State.providers
+	},
+)
+
+type Options struct {
+	types      *string
+	configFile *string
+}
+
+type Deps struct {
+	observer  component.Dep[observer.Observer]
+	client    component.Dep[*kube.Client]
+	informers component.Dep[kube.Informers[dynamicinformer.DynamicSharedInformerFactory]]
+}
+
+type State struct {
+	providers []resource.TypeProvider
+}
+
+func loadConfigs(inline string, path string) ([]TypeConfig, error) {
+	configs := []TypeConfig{}
+
+	if inline != "" {
+		if err := yaml.UnmarshalStrict([]byte(inline), &configs); err != nil {
+			return nil, errors.TagWrapf("ParseInlineConfig", err, "parse --generic-plugin-types")
+		}
+	}
+
+	if path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.TagWrapf("ReadConfigFile", err, "read generic plugin config file")
+		}
+
+		fileConfigs := []TypeConfig{}
+		if err := yaml.UnmarshalStrict(content, &fileConfigs); err != nil {
+			return nil, errors.TagWrapf("ParseConfigFile", err, "parse generic plugin config file %q", path)
+		}
+
+		configs = append(configs, fileConfigs...)
+	}
+
+	return configs, nil
+}
+
+type TypeProvider struct {
+	*CompiledType
+	observer observer.Observer
+	client   dynamic.NamespaceableResourceInterface
+	informer informers.GenericInformer
+}
+
+func (ty *TypeProvider) GetObject(_ context.Context, nsName types.NamespacedName) resource.SourceObject {
+	obj, err := ty.informer.Lister().ByNamespace(nsName.Namespace).Get(nsName.Name)
+	if err != nil || obj == nil {
+		return nil
+	}
+
+	uns, isUnstructured := obj.(*unstructured.Unstructured)
+	if !isUnstructured {
+		return nil
+	}
+
+	return &sourceObject{
+		Unstructured: uns,
+		ty:           ty,
+	}
+}
+
+func (ty *TypeProvider) AddEventHandler(
+	handler func(types.NamespacedName),
+) error {
+	_, err := ty.informer.Informer().AddEventHandler(kube.GenericEventHandler(handler))
+	if err != nil {
+		return errors.TagWrapf(
+			"AddGenericEventHandler",
+			err,
+			"add event handler to %s informer",
+			ty.gvr,
+		)
+	}
+
+	return nil
+}
+
+func (ty *TypeProvider) AddPrereqs(prereqs map[string]worker.Prereq) {
+	prereqs[fmt.Sprintf("generic/%s/informer-sync", ty.gvr)] = worker.InformerPrereq(ty.informer.Informer())
+}
+
+type sourceObject struct {
+	*unstructured.Unstructured
+	ty *TypeProvider
+}
+
+func (obj *sourceObject) TypeDef() resource.TypeDef {
+	return obj.ty
+}
+
+func (obj *sourceObject) MakeDeepCopy() {
+	obj.Unstructured = obj.Unstructured.DeepCopy()
+}
+
+func (obj *sourceObject) GetRequiredProtectors(ctx context.Context) []resource.RequiredProtector {
+	decisions := []string{}
+	output := []resource.RequiredProtector{}
+
+	defer func() {
+		obj.ty.observer.InterpretProtectors(ctx, observer.InterpretProtectors{
+			Group:              obj.ty.gvr.Group,
+			Version:            obj.ty.gvr.Version,
+			Resource:           obj.ty.gvr.Resource,
+			Kind:               obj.ty.gvk.Kind,
+			Namespace:          obj.GetNamespace(),
+			Name:               obj.GetName(),
+			RequiredProtectors: output,
+			Decisions:          decisions,
+		})
+	}()
+
+	if !obj.GetDeletionTimestamp().IsZero() {
+		avoidNonZero := false
+
+		if obj.ty.protectNonZero {
+			// If replicas cannot be evaluated, conservatively assume non-zero.
+			totalReplicas, err := obj.ty.Replicas(obj.Unstructured)
+			if err != nil || totalReplicas > 0 {
+				avoidNonZero = true
+			}
+		}
+
+		if !avoidNonZero {
+			decisions = append(decisions, "Terminating")
+			return nil
+		}
+
+		decisions = append(decisions, "TerminatingButNonZero")
+	}
+
+	if !obj.ty.protectionSelector.Matches(labels.Set(obj.GetLabels())) {
+		decisions = append(decisions, "LabelSelectorMismatch")
+		return nil
+	}
+
+	decisions = append(decisions, "Normal")
+	output = append(output, &defaultReqmt{obj: obj})
+
+	return output
+}
+
+func (obj *sourceObject) Update(ctx context.Context, options metav1.UpdateOptions) error {
+	newObj, err := obj.ty.client.Namespace(obj.GetNamespace()).Update(ctx, obj.Unstructured, options)
+	if err != nil {
+		return errors.TagWrapf("UpdateObject", err, "apiserver error for update request")
+	}
+
+	obj.Unstructured = newObj
+
+	return nil
+}
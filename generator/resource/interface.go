@@ -87,6 +87,19 @@ func GeneratePodProtector(
 		return nil, errors.TagWrapf("ReplicaSpec", err, "inferring replica spec from source object")
 	}
 
+	labels := map[string]string{
+		podseidon.SourceObjectNameLabel: sourceObject.GetName(),
+		podseidon.SourceObjectKindLabel: sourceObject.TypeDef().GroupVersionKind().Kind,
+		podseidon.SourceObjectResourceLabel: sourceObject.TypeDef().
+			GroupVersionResource().Resource,
+		podseidon.SourceObjectGroupLabel: sourceObject.TypeDef().
+			GroupVersionResource().Group,
+	}
+
+	if groupName, hasGroup := sourceObject.GetLabels()[podseidon.PprGroupLabel]; hasGroup {
+		labels[podseidon.PprGroupLabel] = groupName
+	}
+
 	return &podseidonv1a1.PodProtector{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: podseidonv1a1.SchemeGroupVersion.String(),
@@ -95,14 +108,7 @@ func GeneratePodProtector(
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      rqmt.Name(),
 			Namespace: sourceObject.GetNamespace(),
-			Labels: map[string]string{
-				podseidon.SourceObjectNameLabel: sourceObject.GetName(),
-				podseidon.SourceObjectKindLabel: sourceObject.TypeDef().GroupVersionKind().Kind,
-				podseidon.SourceObjectResourceLabel: sourceObject.TypeDef().
-					GroupVersionResource().Resource,
-				podseidon.SourceObjectGroupLabel: sourceObject.TypeDef().
-					GroupVersionResource().Group,
-			},
+			Labels:    labels,
 			Finalizers: []string{
 				podseidon.GeneratorFinalizer,
 			},
@@ -0,0 +1,288 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Imports existing PodDisruptionBudgets as PodProtectors.
+package pdb
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math"
+
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	kubeinformers "k8s.io/client-go/informers"
+	policyv1informers "k8s.io/client-go/informers/policy/v1"
+	policyv1client "k8s.io/client-go/kubernetes/typed/policy/v1"
+
+	podseidon "github.com/kubewharf/podseidon/apis"
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
+
+	"github.com/kubewharf/podseidon/util/component"
+	"github.com/kubewharf/podseidon/util/errors"
+	utilflag "github.com/kubewharf/podseidon/util/flag"
+	"github.com/kubewharf/podseidon/util/kube"
+	"github.com/kubewharf/podseidon/util/o11y"
+	"github.com/kubewharf/podseidon/util/optional"
+	"github.com/kubewharf/podseidon/util/util"
+	"github.com/kubewharf/podseidon/util/worker"
+
+	"github.com/kubewharf/podseidon/generator/constants"
+	"github.com/kubewharf/podseidon/generator/observer"
+	"github.com/kubewharf/podseidon/generator/resource"
+)
+
+var New = component.Declare(
+	func(util.Empty) string { return "pdb-plugin" },
+	func(_ util.Empty, fs *flag.FlagSet) Options {
+		return Options{
+			labelSelector: utilflag.LabelSelectorEverything(
+				fs,
+				"protection-selector",
+				"only import PodDisruptionBudgets matching this selector",
+			),
+		}
+	},
+	func(_ util.Empty, requests *component.DepRequests) Deps {
+		return Deps{
+			observer: o11y.Request[observer.Observer](requests),
+			client: component.DepPtr(requests, kube.NewClient(kube.ClientArgs{
+				ClusterName: constants.CoreClusterName,
+			})),
+			informers: component.DepPtr(requests, kube.NewInformers(kube.NativeInformers(
+				constants.CoreClusterName,
+				constants.LeaderPhase,
+				optional.Some(constants.GeneratorElectorArgs),
+			))),
+		}
+	},
+	func(context.Context, util.Empty, Options, Deps) (*State, error) { return &State{}, nil },
+	component.Lifecycle[util.Empty, Options, Deps, State]{
+		Start:        nil,
+		Join:         nil,
+		HealthChecks: nil,
+	},
+	func(data *component.Data[util.Empty, Options, Deps, State]) resource.TypeProvider {
+		return &TypeProvider{
+			TypeDef:  TypeDef{},
+			options:  &data.Options,
+			observer: data.Deps.observer.Get(),
+			cluster:  data.Deps.client.Get(),
+			informer: data.Deps.informers.Get().Factory.Policy().V1().PodDisruptionBudgets(),
+		}
+	},
+)
+
+type Options struct {
+	labelSelector *labels.Selector
+}
+
+type Deps struct {
+	observer  component.Dep[observer.Observer]
+	client    component.Dep[*kube.Client]
+	informers component.Dep[kube.Informers[kubeinformers.SharedInformerFactory]]
+}
+
+type State struct{}
+
+type TypeDef struct{}
+
+type TypeProvider struct {
+	TypeDef
+	options  *Options
+	observer observer.Observer
+	cluster  *kube.Client
+	informer policyv1informers.PodDisruptionBudgetInformer
+}
+
+func (*TypeDef) GroupVersionResource() schema.GroupVersionResource {
+	return policyv1.SchemeGroupVersion.WithResource("poddisruptionbudgets")
+}
+
+func (*TypeDef) GroupVersionKind() schema.GroupVersionKind {
+	return policyv1.SchemeGroupVersion.WithKind("PodDisruptionBudget")
+}
+
+func (ty *TypeProvider) GetObject(_ context.Context, nsName types.NamespacedName) resource.SourceObject {
+	obj, err := ty.informer.Lister().PodDisruptionBudgets(nsName.Namespace).Get(nsName.Name)
+	if err == nil && obj != nil {
+		return &sourceObject{
+			PodDisruptionBudget: obj,
+			options:             ty.options,
+			client:              ty.cluster.NativeClientSet().PolicyV1(),
+			observer:            ty.observer,
+		}
+	}
+
+	return nil
+}
+
+func (ty *TypeProvider) AddEventHandler(
+	handler func(types.NamespacedName),
+) error {
+	_, err := ty.informer.Informer().AddEventHandler(kube.GenericEventHandler(handler))
+	if err != nil {
+		return errors.TagWrapf(
+			"AddPdbEventHandler",
+			err,
+			"add event handler to poddisruptionbudget informer",
+		)
+	}
+
+	return nil
+}
+
+func (ty *TypeProvider) AddPrereqs(prereqs map[string]worker.Prereq) {
+	prereqs["pdb/informer-sync"] = worker.InformerPrereq(ty.informer.Informer())
+}
+
+type sourceObject struct {
+	*policyv1.PodDisruptionBudget
+	options  *Options
+	client   policyv1client.PolicyV1Interface
+	observer observer.Observer
+}
+
+func (*sourceObject) TypeDef() resource.TypeDef {
+	return &TypeDef{}
+}
+
+func (obj *sourceObject) MakeDeepCopy() {
+	obj.PodDisruptionBudget = obj.PodDisruptionBudget.DeepCopy()
+}
+
+func (obj *sourceObject) GetRequiredProtectors(ctx context.Context) []resource.RequiredProtector {
+	decisions := []string{}
+	output := []resource.RequiredProtector{}
+
+	defer func() {
+		obj.observer.InterpretProtectors(ctx, observer.InterpretProtectors{
+			Group:              obj.TypeDef().GroupVersionResource().Group,
+			Version:            obj.TypeDef().GroupVersionResource().Version,
+			Resource:           obj.TypeDef().GroupVersionResource().Resource,
+			Kind:               obj.TypeDef().GroupVersionKind().Kind,
+			Namespace:          obj.PodDisruptionBudget.Namespace,
+			Name:               obj.PodDisruptionBudget.Name,
+			RequiredProtectors: output,
+			Decisions:          decisions,
+		})
+	}()
+
+	if !obj.PodDisruptionBudget.DeletionTimestamp.IsZero() {
+		decisions = append(decisions, "Terminating")
+		return nil
+	}
+
+	// Shadow PDBs mirror PodProtectors, importing them back would form a loop.
+	if _, isShadow := obj.PodDisruptionBudget.Labels[podseidon.ShadowPdbLabel]; isShadow {
+		decisions = append(decisions, "ShadowPdb")
+		return nil
+	}
+
+	if !(*obj.options.labelSelector).Matches(labels.Set(obj.PodDisruptionBudget.Labels)) {
+		decisions = append(decisions, "LabelSelectorMismatch")
+		return nil
+	}
+
+	// A nil selector in policy/v1 selects no pods, so there is nothing to protect.
+	if obj.PodDisruptionBudget.Spec.Selector == nil {
+		decisions = append(decisions, "NilSelector")
+		return nil
+	}
+
+	decisions = append(decisions, "Normal")
+	output = append(output, &defaultReqmt{obj: obj})
+
+	return output
+}
+
+func (obj *sourceObject) Update(ctx context.Context, options metav1.UpdateOptions) error {
+	newObj, err := obj.client.PodDisruptionBudgets(obj.PodDisruptionBudget.Namespace).
+		Update(ctx, obj.PodDisruptionBudget, options)
+	if err != nil {
+		return errors.TagWrapf("UpdateObject", err, "apiserver error for update request")
+	}
+
+	obj.PodDisruptionBudget = newObj
+
+	return nil
+}
+
+type defaultReqmt struct {
+	obj *sourceObject
+}
+
+func (reqmt *defaultReqmt) Name() string {
+	return fmt.Sprintf("pdb-%s", reqmt.obj.Name)
+}
+
+func (reqmt *defaultReqmt) Spec() (podseidonv1a1.PodProtectorSpec, error) {
+	return SpecFromPdb(reqmt.obj.PodDisruptionBudget)
+}
+
+// Computes the PodProtector spec equivalent to a PodDisruptionBudget.
+//
+// Percentages are resolved against `status.expectedPods` and rounded up,
+// consistent with the disruption controller.
+// If neither minAvailable nor maxUnavailable is set,
+// the disruption controller of policy/v1 requires no healthy pods and allows all disruptions,
+// so the PodProtector is generated with minAvailable 0.
+func SpecFromPdb(pdb *policyv1.PodDisruptionBudget) (_zero podseidonv1a1.PodProtectorSpec, _ error) {
+	expectedPods := int(pdb.Status.ExpectedPods)
+
+	var minAvailable int
+
+	switch {
+	case pdb.Spec.MinAvailable != nil:
+		scaled, err := intstr.GetScaledValueFromIntOrPercent(pdb.Spec.MinAvailable, expectedPods, true)
+		if err != nil {
+			return _zero, errors.TagWrapf("ParseMinAvailable", err, "parse minAvailable from pdb")
+		}
+
+		minAvailable = scaled
+	case pdb.Spec.MaxUnavailable != nil:
+		scaled, err := intstr.GetScaledValueFromIntOrPercent(pdb.Spec.MaxUnavailable, expectedPods, true)
+		if err != nil {
+			return _zero, errors.TagWrapf("ParseMaxUnavailable", err, "parse maxUnavailable from pdb")
+		}
+
+		minAvailable = expectedPods - scaled
+	default:
+		minAvailable = 0
+	}
+
+	if minAvailable > math.MaxInt32 {
+		return _zero, errors.TagErrorf(
+			"TooManyReplicas",
+			"minAvailable overflows after resolution",
+		)
+	}
+
+	var selector metav1.LabelSelector
+	if pdb.Spec.Selector != nil {
+		selector = *pdb.Spec.Selector
+	}
+
+	return podseidonv1a1.PodProtectorSpec{
+		// #nosec G115 -- overflow has been checked
+		MinAvailable:    int32(max(minAvailable, 0)),
+		MinReadySeconds: 0,
+		Selector:        selector,
+	}, nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pdb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubewharf/podseidon/generator/resource/pdb"
+)
+
+//nolint:exhaustruct
+func makePdb(minAvailable *intstr.IntOrString, maxUnavailable *intstr.IntOrString, expectedPods int32) *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			MinAvailable:   minAvailable,
+			MaxUnavailable: maxUnavailable,
+			Selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+		},
+		Status: policyv1.PodDisruptionBudgetStatus{
+			ExpectedPods: expectedPods,
+		},
+	}
+}
+
+func TestSpecFromPdb(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		name           string
+		minAvailable   *intstr.IntOrString
+		maxUnavailable *intstr.IntOrString
+		expectedPods   int32
+		expect         int32
+	}{
+		{name: "MinAvailableInt", minAvailable: ptr.To(intstr.FromInt32(3)), expectedPods: 10, expect: 3},
+		{name: "MinAvailablePercent", minAvailable: ptr.To(intstr.FromString("25%")), expectedPods: 10, expect: 3},
+		{name: "MaxUnavailableInt", maxUnavailable: ptr.To(intstr.FromInt32(2)), expectedPods: 10, expect: 8},
+		{name: "MaxUnavailablePercent", maxUnavailable: ptr.To(intstr.FromString("15%")), expectedPods: 10, expect: 8},
+		{name: "MaxUnavailableExceedsTotal", maxUnavailable: ptr.To(intstr.FromInt32(20)), expectedPods: 10, expect: 0},
+		{name: "Unspecified", expectedPods: 10, expect: 0},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec, err := pdb.SpecFromPdb(makePdb(testCase.minAvailable, testCase.maxUnavailable, testCase.expectedPods))
+			require.NoError(t, err)
+
+			assert.Equal(t, testCase.expect, spec.MinAvailable)
+			assert.Equal(t, map[string]string{"app": "foo"}, spec.Selector.MatchLabels)
+		})
+	}
+}
@@ -0,0 +1,437 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Mirrors PodProtectors as PodDisruptionBudgets for PDB-aware tooling.
+//
+// Shadow PDBs are only advisory.
+// Podseidon webhook never consults them,
+// and they are deleted as soon as the mirrored PodProtector is no longer effective,
+// so that a shadow PDB never outlives the protection it mirrors.
+package shadowpdb
+
+import (
+	"context"
+	"flag"
+	"reflect"
+
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	kubeinformers "k8s.io/client-go/informers"
+	policyv1client "k8s.io/client-go/kubernetes/typed/policy/v1"
+	policyv1listers "k8s.io/client-go/listers/policy/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+
+	podseidon "github.com/kubewharf/podseidon/apis"
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
+	podseidoninformers "github.com/kubewharf/podseidon/client/informers/externalversions"
+	podseidonv1a1listers "github.com/kubewharf/podseidon/client/listers/apis/v1alpha1"
+
+	"github.com/kubewharf/podseidon/util/component"
+	"github.com/kubewharf/podseidon/util/errors"
+	"github.com/kubewharf/podseidon/util/kube"
+	"github.com/kubewharf/podseidon/util/o11y"
+	"github.com/kubewharf/podseidon/util/optional"
+	pprutil "github.com/kubewharf/podseidon/util/podprotector"
+	"github.com/kubewharf/podseidon/util/util"
+	"github.com/kubewharf/podseidon/util/worker"
+
+	"github.com/kubewharf/podseidon/generator/constants"
+	"github.com/kubewharf/podseidon/generator/observer"
+)
+
+// Prefix of shadow PodDisruptionBudget names, followed by the PodProtector name.
+const NamePrefix = "podseidon-"
+
+var New = component.Declare(
+	func(Args) string { return "generator-shadow-pdb" },
+	func(_ Args, fs *flag.FlagSet) Options {
+		return Options{
+			Enable: fs.Bool(
+				"enable",
+				false,
+				"Maintain a shadow PodDisruptionBudget for each PodProtector for PDB-aware tooling",
+			),
+		}
+	},
+	func(_ Args, requests *component.DepRequests) Deps {
+		return Deps{
+			cluster: component.DepPtr(requests, kube.NewClient(kube.ClientArgs{
+				ClusterName: constants.CoreClusterName,
+			})),
+			elector: component.DepPtr(requests, kube.NewElector(constants.GeneratorElectorArgs)),
+			nativeInformers: component.DepPtr(requests, kube.NewInformers(kube.NativeInformers(
+				constants.CoreClusterName,
+				constants.LeaderPhase,
+				optional.Some(constants.GeneratorElectorArgs),
+			))),
+			podseidonInformers: component.DepPtr(requests, kube.NewInformers(kube.PodseidonInformers(
+				constants.CoreClusterName,
+				constants.LeaderPhase,
+				optional.Some(constants.GeneratorElectorArgs),
+			))),
+			observer: o11y.Request[observer.Observer](requests),
+			worker: component.DepPtr(requests, worker.New[types.NamespacedName](
+				"shadow-pdb",
+				clock.RealClock{},
+			)),
+		}
+	},
+	func(_ context.Context, _ Args, options Options, deps Deps) (*State, error) {
+		queue := deps.worker.Get()
+
+		if !*options.Enable {
+			queue.SetExecutor(func(context.Context, types.NamespacedName) error { return nil }, map[string]worker.Prereq{})
+			return &State{}, nil
+		}
+
+		pprInformer := deps.podseidonInformers.Get().Factory.Podseidon().V1alpha1().PodProtectors()
+		pdbInformer := deps.nativeInformers.Get().Factory.Policy().V1().PodDisruptionBudgets()
+
+		queue.SetExecutor(
+			func(ctx context.Context, item types.NamespacedName) error {
+				action, err := Reconcile(
+					ctx,
+					deps.cluster.Get().NativeClientSet().PolicyV1(),
+					pprInformer.Lister(),
+					pdbInformer.Lister(),
+					item,
+				)
+				if err != nil {
+					action = observer.ShadowPdbActionError
+				}
+
+				deps.observer.Get().SyncShadowPdb(ctx, observer.SyncShadowPdb{
+					Namespace: item.Namespace,
+					PprName:   item.Name,
+					Action:    action,
+					Err:       err,
+				})
+
+				return err
+			},
+			map[string]worker.Prereq{
+				"ppr-informer-synced": worker.InformerPrereq(pprInformer.Informer()),
+				"pdb-informer-synced": worker.InformerPrereq(pdbInformer.Informer()),
+			},
+		)
+		queue.SetBeforeStart(deps.elector.Get().Await)
+
+		if _, err := pprInformer.Informer().AddEventHandler(kube.GenericEventHandler(queue.Enqueue)); err != nil {
+			return nil, errors.TagWrapf("AddPprEventHandler", err, "add event handler to ppr informer")
+		}
+
+		if _, err := pdbInformer.Informer().AddEventHandler(kube.GenericEventHandlerWithStaleState(
+			func(pdb *policyv1.PodDisruptionBudget, _ bool) {
+				if pprName, isShadow := pdb.Labels[podseidon.ShadowPdbLabel]; isShadow {
+					queue.Enqueue(types.NamespacedName{Namespace: pdb.Namespace, Name: pprName})
+					return
+				}
+
+				// A user PDB may start or stop overlapping with any PodProtector in the namespace.
+				pprs, err := pprInformer.Lister().PodProtectors(pdb.Namespace).List(labels.Everything())
+				if err != nil {
+					return
+				}
+
+				for _, ppr := range pprs {
+					queue.Enqueue(types.NamespacedName{Namespace: ppr.Namespace, Name: ppr.Name})
+				}
+			},
+		)); err != nil {
+			return nil, errors.TagWrapf("AddPdbEventHandler", err, "add event handler to pdb informer")
+		}
+
+		return &State{}, nil
+	},
+	component.Lifecycle[Args, Options, Deps, State]{
+		Start:        nil,
+		Join:         nil,
+		HealthChecks: nil,
+	},
+	func(*component.Data[Args, Options, Deps, State]) util.Empty { return util.Empty{} },
+)
+
+type Args struct{}
+
+type Options struct {
+	Enable *bool
+}
+
+type Deps struct {
+	cluster            component.Dep[*kube.Client]
+	elector            component.Dep[*kube.Elector]
+	nativeInformers    component.Dep[kube.Informers[kubeinformers.SharedInformerFactory]]
+	podseidonInformers component.Dep[kube.Informers[podseidoninformers.SharedInformerFactory]]
+	observer           component.Dep[observer.Observer]
+	worker             component.Dep[worker.Api[types.NamespacedName]]
+}
+
+type State struct{}
+
+// Reconciles the shadow PodDisruptionBudget of the named PodProtector.
+func Reconcile(
+	ctx context.Context,
+	pdbClient policyv1client.PolicyV1Interface,
+	pprLister podseidonv1a1listers.PodProtectorLister,
+	pdbLister policyv1listers.PodDisruptionBudgetLister,
+	pprNsName types.NamespacedName,
+) (observer.ShadowPdbAction, error) {
+	var desiredOpt optional.Optional[*policyv1.PodDisruptionBudget]
+
+	overlapping := false
+
+	ppr, err := pprLister.PodProtectors(pprNsName.Namespace).Get(pprNsName.Name)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return observer.ShadowPdbActionError, errors.TagWrapf("GetPpr", err, "get PodProtector from lister")
+	}
+
+	if err == nil && ShouldMirror(ppr) {
+		pdbs, err := pdbLister.PodDisruptionBudgets(pprNsName.Namespace).List(labels.Everything())
+		if err != nil {
+			return observer.ShadowPdbActionError, errors.TagWrapf("ListPdb", err, "list PodDisruptionBudgets from lister")
+		}
+
+		if HasOverlappingPdb(ppr, pdbs) {
+			overlapping = true
+		} else {
+			desiredOpt = optional.Some(MakeShadowPdb(ppr))
+		}
+	}
+
+	pdbName := NamePrefix + pprNsName.Name
+
+	current, err := pdbLister.PodDisruptionBudgets(pprNsName.Namespace).Get(pdbName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return observer.ShadowPdbActionError, errors.TagWrapf("GetPdb", err, "get PodDisruptionBudget from lister")
+	}
+
+	if err != nil {
+		current = nil
+	}
+
+	if current != nil && current.Labels[podseidon.ShadowPdbLabel] != pprNsName.Name {
+		// Never touch PDBs that are not created by us.
+		return observer.ShadowPdbActionConflict, nil
+	}
+
+	desired, hasDesired := desiredOpt.Get()
+
+	switch {
+	case current == nil && !hasDesired:
+		if overlapping {
+			return observer.ShadowPdbActionOverlap, nil
+		}
+
+		return observer.ShadowPdbActionNoop, nil
+
+	case current == nil && hasDesired:
+		_, err := pdbClient.PodDisruptionBudgets(desired.Namespace).Create(ctx, desired, metav1.CreateOptions{})
+		if err != nil {
+			return observer.ShadowPdbActionCreate, errors.TagWrapf("CreatePdb", err, "create shadow PodDisruptionBudget")
+		}
+
+		return observer.ShadowPdbActionCreate, nil
+
+	case current != nil && !hasDesired:
+		if !current.DeletionTimestamp.IsZero() {
+			return observer.ShadowPdbActionNoop, nil
+		}
+
+		err := pdbClient.PodDisruptionBudgets(current.Namespace).Delete(ctx, current.Name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{UID: &current.UID, ResourceVersion: nil},
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return observer.ShadowPdbActionDelete, errors.TagWrapf("DeletePdb", err, "delete shadow PodDisruptionBudget")
+		}
+
+		return observer.ShadowPdbActionDelete, nil
+
+	default:
+		if reflect.DeepEqual(current.Spec, desired.Spec) &&
+			reflect.DeepEqual(current.OwnerReferences, desired.OwnerReferences) {
+			return observer.ShadowPdbActionNoop, nil
+		}
+
+		next := current.DeepCopy()
+		next.Spec = desired.Spec
+		next.OwnerReferences = desired.OwnerReferences
+
+		_, err := pdbClient.PodDisruptionBudgets(next.Namespace).Update(ctx, next, metav1.UpdateOptions{})
+		if err != nil {
+			return observer.ShadowPdbActionUpdate, errors.TagWrapf("UpdatePdb", err, "update shadow PodDisruptionBudget")
+		}
+
+		return observer.ShadowPdbActionUpdate, nil
+	}
+}
+
+// Whether a shadow PDB should exist for the PodProtector.
+//
+// PodProtectors imported from a PDB are not mirrored,
+// since the eviction API rejects pods matched by multiple PDBs.
+// PodProtectors that are no longer effective (terminating without the generator finalizer)
+// are not mirrored either, so that the shadow PDB never becomes the only protection.
+func ShouldMirror(ppr *podseidonv1a1.PodProtector) bool {
+	if ppr.Labels[podseidon.SourceObjectGroupLabel] == policyv1.GroupName &&
+		ppr.Labels[podseidon.SourceObjectKindLabel] == "PodDisruptionBudget" {
+		return false
+	}
+
+	if !ppr.DeletionTimestamp.IsZero() &&
+		util.FindInSlice(ppr.Finalizers, podseidon.GeneratorFinalizer) == -1 {
+		return false
+	}
+
+	return true
+}
+
+// Whether the PodProtector may select pods that are also selected by a PDB not managed by generator.
+//
+// The eviction API rejects evictions of pods matched by multiple PDBs,
+// so such PodProtectors are not mirrored, leaving the existing PDB as the only one.
+// Since pods are not known here, selectors are considered overlapping
+// unless some label key has requirements that no label set can satisfy together.
+func HasOverlappingPdb(ppr *podseidonv1a1.PodProtector, pdbs []*policyv1.PodDisruptionBudget) bool {
+	pprSelector, err := metav1.LabelSelectorAsSelector(&ppr.Spec.Selector)
+	if err != nil {
+		return false
+	}
+
+	for _, pdb := range pdbs {
+		if _, isShadow := pdb.Labels[podseidon.ShadowPdbLabel]; isShadow || pdb.Spec.Selector == nil {
+			continue
+		}
+
+		pdbSelector, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil {
+			continue
+		}
+
+		if selectorsMayOverlap(pprSelector, pdbSelector) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func selectorsMayOverlap(left, right labels.Selector) bool {
+	leftReqs, _ := left.Requirements()
+	rightReqs, _ := right.Requirements()
+
+	for _, leftReq := range leftReqs {
+		for _, rightReq := range rightReqs {
+			if leftReq.Key() == rightReq.Key() && requirementsDisjoint(leftReq, rightReq) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Whether no value (or absence) of the key satisfies both requirements on the same key.
+// Numeric comparisons are conservatively assumed to be satisfiable.
+func requirementsDisjoint(left, right labels.Requirement) bool {
+	leftValues, leftKind := classifyRequirement(left)
+	rightValues, rightKind := classifyRequirement(right)
+
+	if leftKind > rightKind {
+		leftValues, leftKind, rightValues, rightKind = rightValues, rightKind, leftValues, leftKind
+	}
+
+	switch {
+	case leftKind == requirementIn && rightKind == requirementIn:
+		return !leftValues.HasAny(rightValues.UnsortedList()...)
+	case leftKind == requirementIn && rightKind == requirementNotIn:
+		return rightValues.IsSuperset(leftValues)
+	case leftKind == requirementIn && rightKind == requirementAbsent:
+		return true
+	case leftKind == requirementPresent && rightKind == requirementAbsent:
+		return true
+	default:
+		return false
+	}
+}
+
+type requirementKind int
+
+const (
+	requirementIn requirementKind = iota
+	requirementPresent
+	requirementNotIn
+	requirementAbsent
+	requirementOther
+)
+
+func classifyRequirement(req labels.Requirement) (sets.Set[string], requirementKind) {
+	switch req.Operator() {
+	case selection.In, selection.Equals, selection.DoubleEquals:
+		return sets.New(req.Values().UnsortedList()...), requirementIn
+	case selection.Exists:
+		return nil, requirementPresent
+	case selection.NotIn, selection.NotEquals:
+		return sets.New(req.Values().UnsortedList()...), requirementNotIn
+	case selection.DoesNotExist:
+		return nil, requirementAbsent
+	default:
+		return nil, requirementOther
+	}
+}
+
+// Generates the desired shadow PDB for a PodProtector.
+func MakeShadowPdb(ppr *podseidonv1a1.PodProtector) *policyv1.PodDisruptionBudget {
+	//nolint:exhaustruct // leave other fields as default
+	spec := policyv1.PodDisruptionBudgetSpec{
+		Selector: ppr.Spec.Selector.DeepCopy(),
+	}
+
+	if ppr.Spec.MaxUnavailable != nil && ppr.Spec.MinAvailable == 0 {
+		spec.MaxUnavailable = ptr.To(*ppr.Spec.MaxUnavailable)
+	} else {
+		// A PDB cannot express both requirements at once, so the resolved requirement is mirrored.
+		spec.MinAvailable = ptr.To(intstr.FromInt32(pprutil.ResolveMinAvailable(ppr.Spec, ppr.Status.Summary.Total)))
+	}
+
+	//nolint:exhaustruct // leave other fields as default
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: ppr.Namespace,
+			Name:      NamePrefix + ppr.Name,
+			Labels: map[string]string{
+				podseidon.ShadowPdbLabel: ppr.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				// Garbage collector removes the shadow PDB
+				// even if generator is not running when the PodProtector is deleted.
+				{
+					APIVersion:         podseidonv1a1.SchemeGroupVersion.String(),
+					Kind:               podseidonv1a1.PodProtectorKind,
+					Name:               ppr.Name,
+					UID:                ppr.UID,
+					Controller:         ptr.To(true),
+					BlockOwnerDeletion: ptr.To(false),
+				},
+			},
+		},
+		Spec: spec,
+	}
+}
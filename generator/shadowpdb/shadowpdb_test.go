@@ -0,0 +1,289 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shadowpdb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	kubernetesfake "k8s.io/client-go/kubernetes/fake"
+	policyv1listers "k8s.io/client-go/listers/policy/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+
+	podseidon "github.com/kubewharf/podseidon/apis"
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
+	podseidonv1a1listers "github.com/kubewharf/podseidon/client/listers/apis/v1alpha1"
+
+	"github.com/kubewharf/podseidon/generator/observer"
+	"github.com/kubewharf/podseidon/generator/shadowpdb"
+)
+
+var pprNsName = types.NamespacedName{Namespace: "default", Name: "deployment-foo"}
+
+//nolint:exhaustruct
+func makePpr(minAvailable int32, sourceGroup string, sourceKind string) *podseidonv1a1.PodProtector {
+	return &podseidonv1a1.PodProtector{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: pprNsName.Namespace,
+			Name:      pprNsName.Name,
+			UID:       "ppr-uid",
+			Labels: map[string]string{
+				podseidon.SourceObjectGroupLabel: sourceGroup,
+				podseidon.SourceObjectKindLabel:  sourceKind,
+			},
+			Finalizers: []string{podseidon.GeneratorFinalizer},
+		},
+		Spec: podseidonv1a1.PodProtectorSpec{
+			MinAvailable: minAvailable,
+			Selector:     metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+		},
+	}
+}
+
+func reconcile(
+	t *testing.T,
+	pprs []*podseidonv1a1.PodProtector,
+	pdbs []*policyv1.PodDisruptionBudget,
+) (observer.ShadowPdbAction, *kubernetesfake.Clientset) {
+	t.Helper()
+
+	pprIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, ppr := range pprs {
+		require.NoError(t, pprIndexer.Add(ppr))
+	}
+
+	pdbIndexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	pdbObjects := make([]runtime.Object, 0, len(pdbs))
+
+	for _, pdb := range pdbs {
+		require.NoError(t, pdbIndexer.Add(pdb))
+		pdbObjects = append(pdbObjects, pdb)
+	}
+
+	client := kubernetesfake.NewSimpleClientset(pdbObjects...)
+
+	action, err := shadowpdb.Reconcile(
+		context.Background(),
+		client.PolicyV1(),
+		podseidonv1a1listers.NewPodProtectorLister(pprIndexer),
+		policyv1listers.NewPodDisruptionBudgetLister(pdbIndexer),
+		pprNsName,
+	)
+	require.NoError(t, err)
+
+	return action, client
+}
+
+func getShadowPdb(t *testing.T, client *kubernetesfake.Clientset) *policyv1.PodDisruptionBudget {
+	t.Helper()
+
+	pdb, err := client.PolicyV1().PodDisruptionBudgets(pprNsName.Namespace).
+		Get(context.Background(), shadowpdb.NamePrefix+pprNsName.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+
+	require.NoError(t, err)
+
+	return pdb
+}
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	action, client := reconcile(t, []*podseidonv1a1.PodProtector{makePpr(3, "apps", "Deployment")}, nil)
+	assert.Equal(t, observer.ShadowPdbActionCreate, action)
+
+	pdb := getShadowPdb(t, client)
+	require.NotNil(t, pdb)
+	assert.Equal(t, int32(3), pdb.Spec.MinAvailable.IntVal)
+	assert.Equal(t, map[string]string{"app": "foo"}, pdb.Spec.Selector.MatchLabels)
+	assert.Equal(t, pprNsName.Name, pdb.Labels[podseidon.ShadowPdbLabel])
+}
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+
+	existing := shadowpdb.MakeShadowPdb(makePpr(3, "apps", "Deployment"))
+
+	action, client := reconcile(
+		t,
+		[]*podseidonv1a1.PodProtector{makePpr(5, "apps", "Deployment")},
+		[]*policyv1.PodDisruptionBudget{existing},
+	)
+	assert.Equal(t, observer.ShadowPdbActionUpdate, action)
+
+	pdb := getShadowPdb(t, client)
+	require.NotNil(t, pdb)
+	assert.Equal(t, int32(5), pdb.Spec.MinAvailable.IntVal)
+}
+
+func TestNoop(t *testing.T) {
+	t.Parallel()
+
+	ppr := makePpr(3, "apps", "Deployment")
+
+	action, _ := reconcile(
+		t,
+		[]*podseidonv1a1.PodProtector{ppr},
+		[]*policyv1.PodDisruptionBudget{shadowpdb.MakeShadowPdb(ppr)},
+	)
+	assert.Equal(t, observer.ShadowPdbActionNoop, action)
+}
+
+func TestDeleteWhenPprAbsent(t *testing.T) {
+	t.Parallel()
+
+	existing := shadowpdb.MakeShadowPdb(makePpr(3, "apps", "Deployment"))
+
+	action, client := reconcile(t, nil, []*policyv1.PodDisruptionBudget{existing})
+	assert.Equal(t, observer.ShadowPdbActionDelete, action)
+	assert.Nil(t, getShadowPdb(t, client))
+}
+
+func TestDeleteWhenPprNotEffective(t *testing.T) {
+	t.Parallel()
+
+	ppr := makePpr(3, "apps", "Deployment")
+	existing := shadowpdb.MakeShadowPdb(ppr)
+
+	ppr.Finalizers = nil
+	ppr.DeletionTimestamp = ptr.To(metav1.NewTime(time.Unix(1, 0)))
+
+	action, client := reconcile(t, []*podseidonv1a1.PodProtector{ppr}, []*policyv1.PodDisruptionBudget{existing})
+	assert.Equal(t, observer.ShadowPdbActionDelete, action)
+	assert.Nil(t, getShadowPdb(t, client))
+}
+
+func TestSkipImportedPdb(t *testing.T) {
+	t.Parallel()
+
+	action, client := reconcile(t, []*podseidonv1a1.PodProtector{makePpr(3, "policy", "PodDisruptionBudget")}, nil)
+	assert.Equal(t, observer.ShadowPdbActionNoop, action)
+	assert.Nil(t, getShadowPdb(t, client))
+}
+
+func TestConflict(t *testing.T) {
+	t.Parallel()
+
+	//nolint:exhaustruct
+	existing := &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: pprNsName.Namespace,
+			Name:      shadowpdb.NamePrefix + pprNsName.Name,
+		},
+	}
+
+	action, client := reconcile(t, nil, []*policyv1.PodDisruptionBudget{existing})
+	assert.Equal(t, observer.ShadowPdbActionConflict, action)
+	assert.NotNil(t, getShadowPdb(t, client))
+}
+
+//nolint:exhaustruct
+func makeUserPdb(selector *metav1.LabelSelector) *policyv1.PodDisruptionBudget {
+	return &policyv1.PodDisruptionBudget{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: pprNsName.Namespace,
+			Name:      "user-pdb",
+		},
+		Spec: policyv1.PodDisruptionBudgetSpec{
+			Selector: selector,
+		},
+	}
+}
+
+func TestSkipOverlappingPdb(t *testing.T) {
+	t.Parallel()
+
+	userPdb := makeUserPdb(&metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo", "tier": "web"}})
+
+	action, client := reconcile(
+		t,
+		[]*podseidonv1a1.PodProtector{makePpr(3, "apps", "Deployment")},
+		[]*policyv1.PodDisruptionBudget{userPdb},
+	)
+	assert.Equal(t, observer.ShadowPdbActionOverlap, action)
+	assert.Nil(t, getShadowPdb(t, client))
+}
+
+func TestDeleteWhenOverlapping(t *testing.T) {
+	t.Parallel()
+
+	ppr := makePpr(3, "apps", "Deployment")
+	userPdb := makeUserPdb(&metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}})
+
+	action, client := reconcile(
+		t,
+		[]*podseidonv1a1.PodProtector{ppr},
+		[]*policyv1.PodDisruptionBudget{shadowpdb.MakeShadowPdb(ppr), userPdb},
+	)
+	assert.Equal(t, observer.ShadowPdbActionDelete, action)
+	assert.Nil(t, getShadowPdb(t, client))
+}
+
+func TestHasOverlappingPdb(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		name     string
+		selector *metav1.LabelSelector
+		expect   bool
+	}{
+		{name: "SameLabels", selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}, expect: true},
+		{name: "UnrelatedKey", selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "web"}}, expect: true},
+		{name: "DifferentValue", selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "bar"}}, expect: false},
+		{
+			name: "NotInCoversValue",
+			selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"foo", "bar"}},
+			}},
+			expect: false,
+		},
+		{
+			name: "NotInOtherValue",
+			selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"bar"}},
+			}},
+			expect: true,
+		},
+		{
+			name: "DoesNotExist",
+			selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: metav1.LabelSelectorOpDoesNotExist},
+			}},
+			expect: false,
+		},
+		{name: "NilSelector", selector: nil, expect: false},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(
+				t,
+				testCase.expect,
+				shadowpdb.HasOverlappingPdb(makePpr(3, "apps", "Deployment"), []*policyv1.PodDisruptionBudget{makeUserPdb(testCase.selector)}),
+			)
+		})
+	}
+}
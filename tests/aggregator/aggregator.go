@@ -131,7 +131,7 @@ var _ = ginkgo.Describe("Aggregator", func() {
 						},
 					}
 
-					pprutil.Summarize(config, ppr)
+					pprutil.Summarize(config, ppr, time.Now())
 
 					ginkgo.GinkgoLogr.Info("debug", "aggregation rate", config.AggregationRate, "spec", ppr.Spec.AdmissionHistoryConfig)
 					expireTime = admissionTime.Add(config.AggregationRate * 2)
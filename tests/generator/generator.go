@@ -198,4 +198,250 @@ var _ = ginkgo.Describe("Generator", func() {
 			})
 		})
 	})
+
+	ginkgo.Context("StatefulSet", func() {
+		const workloadName string = "workload"
+
+		ginkgo.It("maintains the lifecycle of a child PodProtector", func(ctx ginkgo.SpecContext) {
+			stsClient := env.CoreCluster().NativeClient.AppsV1().StatefulSets(env.Namespace)
+
+			ginkgo.By("Creating statefulset", func() {
+				env.ReportKelemetryTrace(testutil.CoreClusterId, appsv1.SchemeGroupVersion.WithResource("statefulsets"), workloadName)
+
+				_, err := stsClient.Create(ctx, &appsv1.StatefulSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   workloadName,
+						Labels: map[string]string{"test": env.Namespace},
+					},
+					Spec: appsv1.StatefulSetSpec{
+						Replicas:        ptr.To[int32](10),
+						MinReadySeconds: 15,
+						ServiceName:     workloadName,
+						UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+							Type: appsv1.RollingUpdateStatefulSetStrategyType,
+						},
+						Selector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"test": env.Namespace},
+						},
+						Template: corev1.PodTemplateSpec{
+							ObjectMeta: metav1.ObjectMeta{
+								Labels: map[string]string{"test": env.Namespace},
+							},
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{
+									{
+										Name:  "container",
+										Image: "example.com/kwok/no:image",
+									},
+								},
+							},
+						},
+					},
+				}, metav1.CreateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			})
+
+			ginkgo.By("Waiting for finalizer on statefulset", func() {
+				testutil.ExpectObject[*appsv1.StatefulSet](
+					ctx,
+					stsClient.Watch,
+					workloadName,
+					synchronousReconcileTimeout,
+					gomega.WithTransform(
+						(*appsv1.StatefulSet).GetFinalizers,
+						gomega.ContainElement(podseidon.GeneratorFinalizer),
+					),
+				)
+			})
+
+			pprName := fmt.Sprintf("statefulset-%s", workloadName)
+
+			ginkgo.By("Waiting for PodProtector creation", func() {
+				// maxUnavailable defaults to 1 for statefulsets
+				testutil.ExpectObject[*podseidonv1a1.PodProtector](
+					ctx,
+					env.PprClient().Watch,
+					pprName,
+					synchronousReconcileTimeout,
+					gomega.WithTransform(
+						(*podseidonv1a1.PodProtector).GetFinalizers,
+						gomega.ContainElement(podseidon.GeneratorFinalizer),
+					),
+					gomega.WithTransform(
+						func(ppr *podseidonv1a1.PodProtector) any { return ppr.Spec.MinAvailable },
+						gomega.Equal(int32(9)),
+					),
+					gomega.WithTransform(
+						func(ppr *podseidonv1a1.PodProtector) any { return ppr.Spec.MinReadySeconds },
+						gomega.Equal(int32(15)),
+					),
+				)
+			})
+
+			ginkgo.By("Scaling PodProtector", func() {
+				testutil.DoUpdate(
+					ctx,
+					stsClient.Get,
+					stsClient.Update,
+					workloadName,
+					func(sts *appsv1.StatefulSet) {
+						sts.Spec.Replicas = ptr.To(int32(20))
+					},
+				)
+			})
+
+			ginkgo.By("Waiting for PodProtector update", func() {
+				testutil.ExpectObject[*podseidonv1a1.PodProtector](
+					ctx,
+					env.PprClient().Watch,
+					pprName,
+					synchronousReconcileTimeout,
+					gomega.WithTransform(
+						func(ppr *podseidonv1a1.PodProtector) any { return ppr.Spec.MinAvailable },
+						gomega.Equal(int32(19)),
+					),
+				)
+			})
+
+			ginkgo.By("Deleting workload", func() {
+				err := stsClient.Delete(ctx, workloadName, metav1.DeleteOptions{})
+				gomega.Expect(err).ShouldNot(gomega.HaveOccurred())
+			})
+
+			ginkgo.By("PodProtector should disappear", func() {
+				gomega.Eventually(ctx, func() error {
+					_, err := env.PprClient().Get(ctx, pprName, metav1.GetOptions{})
+					return err
+				}).WithTimeout(synchronousReconcileTimeout).Should(
+					gomega.WithTransform(apierrors.ReasonForError, gomega.Equal(metav1.StatusReasonNotFound)),
+				)
+			})
+		})
+	})
+
+	ginkgo.Context("DaemonSet", func() {
+		const workloadName string = "workload"
+
+		// DaemonSet status is populated asynchronously by the daemonset controller.
+		const statusReconcileTimeout time.Duration = time.Second * 10
+
+		ginkgo.It("maintains the lifecycle of a child PodProtector", func(ctx ginkgo.SpecContext) {
+			dsClient := env.CoreCluster().NativeClient.AppsV1().DaemonSets(env.Namespace)
+
+			ginkgo.By("Creating daemonset", func() {
+				env.ReportKelemetryTrace(testutil.CoreClusterId, appsv1.SchemeGroupVersion.WithResource("daemonsets"), workloadName)
+
+				_, err := dsClient.Create(ctx, &appsv1.DaemonSet{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   workloadName,
+						Labels: map[string]string{"test": env.Namespace},
+					},
+					Spec: appsv1.DaemonSetSpec{
+						MinReadySeconds: 15,
+						UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+							Type: appsv1.RollingUpdateDaemonSetStrategyType,
+							RollingUpdate: &appsv1.RollingUpdateDaemonSet{
+								MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+								MaxSurge:       ptr.To(intstr.FromInt32(1)),
+							},
+						},
+						Selector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"test": env.Namespace},
+						},
+						Template: corev1.PodTemplateSpec{
+							ObjectMeta: metav1.ObjectMeta{
+								Labels: map[string]string{"test": env.Namespace},
+							},
+							Spec: corev1.PodSpec{
+								NodeSelector: map[string]string{"type": "e2e"},
+								Containers: []corev1.Container{
+									{
+										Name:  "container",
+										Image: "example.com/kwok/no:image",
+									},
+								},
+							},
+						},
+					},
+				}, metav1.CreateOptions{})
+				gomega.Expect(err).NotTo(gomega.HaveOccurred())
+			})
+
+			ginkgo.By("Waiting for finalizer on daemonset", func() {
+				testutil.ExpectObject[*appsv1.DaemonSet](
+					ctx,
+					dsClient.Watch,
+					workloadName,
+					synchronousReconcileTimeout,
+					gomega.WithTransform(
+						(*appsv1.DaemonSet).GetFinalizers,
+						gomega.ContainElement(podseidon.GeneratorFinalizer),
+					),
+				)
+			})
+
+			pprName := fmt.Sprintf("daemonset-%s", workloadName)
+
+			ginkgo.By("Waiting for PodProtector creation", func() {
+				// the only e2e node is scheduled, and maxUnavailable is 0
+				testutil.ExpectObject[*podseidonv1a1.PodProtector](
+					ctx,
+					env.PprClient().Watch,
+					pprName,
+					statusReconcileTimeout,
+					gomega.WithTransform(
+						(*podseidonv1a1.PodProtector).GetFinalizers,
+						gomega.ContainElement(podseidon.GeneratorFinalizer),
+					),
+					gomega.WithTransform(
+						func(ppr *podseidonv1a1.PodProtector) any { return ppr.Spec.MinAvailable },
+						gomega.Equal(int32(1)),
+					),
+					gomega.WithTransform(
+						func(ppr *podseidonv1a1.PodProtector) any { return ppr.Spec.MinReadySeconds },
+						gomega.Equal(int32(15)),
+					),
+				)
+			})
+
+			ginkgo.By("Unscheduling daemonset from all nodes", func() {
+				testutil.DoUpdate(
+					ctx,
+					dsClient.Get,
+					dsClient.Update,
+					workloadName,
+					func(ds *appsv1.DaemonSet) {
+						ds.Spec.Template.Spec.NodeSelector = map[string]string{"type": "nonexistent"}
+					},
+				)
+			})
+
+			ginkgo.By("Waiting for PodProtector update", func() {
+				testutil.ExpectObject[*podseidonv1a1.PodProtector](
+					ctx,
+					env.PprClient().Watch,
+					pprName,
+					statusReconcileTimeout,
+					gomega.WithTransform(
+						func(ppr *podseidonv1a1.PodProtector) any { return ppr.Spec.MinAvailable },
+						gomega.Equal(int32(0)),
+					),
+				)
+			})
+
+			ginkgo.By("Deleting workload", func() {
+				err := dsClient.Delete(ctx, workloadName, metav1.DeleteOptions{})
+				gomega.Expect(err).ShouldNot(gomega.HaveOccurred())
+			})
+
+			ginkgo.By("PodProtector should disappear", func() {
+				gomega.Eventually(ctx, func() error {
+					_, err := env.PprClient().Get(ctx, pprName, metav1.GetOptions{})
+					return err
+				}).WithTimeout(synchronousReconcileTimeout).Should(
+					gomega.WithTransform(apierrors.ReasonForError, gomega.Equal(metav1.StatusReasonNotFound)),
+				)
+			})
+		})
+	})
 })
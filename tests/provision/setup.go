@@ -358,6 +358,10 @@ func (setup *Setup) DeployPodseidon(ctx context.Context) error {
 		return err
 	}
 
+	workerKubeconfigPaths := util.MapSlice(setup.Request.Count.WorkerClusterIds(), func(cluster ClusterId) iter.Pair[string, string] {
+		return iter.NewPair(cluster.String(), setup.Env.Cluster(cluster).containerKubeconfigPath)
+	})
+
 	if err := setup.DockerRun(setup.Images.Podseidon.Webhook, "podseidon-webhook").
 		AddNetwork(setup.kwokNetworkNames()...).
 		DockerOption("hostname", "podseidon-webhook").
@@ -366,6 +370,8 @@ func (setup *Setup) DeployPodseidon(ctx context.Context) error {
 		Option("webhook-enable", "false").Option("webhook-https-enable", "true").
 		OptionMount("webhook-https-cert-file", setup.Paths.AssetPath("webhook.pem")).
 		OptionMount("webhook-https-key-file", setup.Paths.AssetPath("webhook-key.pem")).
+		Option("webhook-pod-getter", "by-cell").
+		OptionMountMap("webhook-pod-getter.by-cell-kubeconfig-paths", workerKubeconfigPaths).
 		Option("klog-v", "6").
 		Option("healthz-bind-addr", "0.0.0.0").Option("healthz-port", "8081").
 		Option("pprof-bind-addr", "0.0.0.0").Option("pprof-port", "6060").
@@ -20,6 +20,7 @@ import (
 	"github.com/onsi/ginkgo/v2"
 	"github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -110,6 +111,61 @@ var _ = ginkgo.Describe("Webhook", func() {
 		})
 	})
 
+	ginkgo.It("allows normal eviction and rejects extra evictions with 429", func(ctx ginkgo.SpecContext) {
+		ginkgo.By("Setup PodProtector and worker pods", func() {
+			fixtures.CreatePodProtectorAndPods(
+				ctx, &env, pprName,
+				testutil.PodCounts{1: 3, 2: 4},
+				5, 0,
+				podseidonv1a1.AdmissionHistoryConfig{
+					MaxConcurrentLag:      nil,
+					CompactThreshold:      ptr.To[int32](100),
+					AggregationRateMillis: ptr.To[int32](2000),
+				},
+			)
+		})
+
+		ginkgo.By("Mark pods as ready", func() {
+			readyTime := time.Now()
+
+			for _, podId := range (testutil.PodCounts{1: 3, 2: 4}).PodIds() {
+				fixtures.MarkPodAsReady(ctx, &env, podId, readyTime)
+			}
+		})
+
+		ginkgo.By("Wait for PodProtector state to converge", func() {
+			testutil.ExpectObject[*podseidonv1a1.PodProtector](
+				ctx,
+				env.PprClient().Watch,
+				pprName,
+				aggregatorReconcileTimeout,
+				testutil.MatchPprStatus(7, 7, 7, map[testutil.ClusterId]int32{1: 3, 2: 4}, map[testutil.ClusterId]int32{1: 3, 2: 4}),
+			)
+		})
+
+		evict := func(podId testutil.PodId) error {
+			//nolint:exhaustruct
+			return env.PodClient(podId.Cluster).EvictV1(ctx, &policyv1.Eviction{
+				ObjectMeta: metav1.ObjectMeta{Namespace: env.Namespace, Name: podId.PodName()},
+			})
+		}
+
+		ginkgo.By("Validate that we can evict one pod from each cluster", func() {
+			for _, cluster := range env.WorkerClusters() {
+				err := evict(testutil.PodId{Cluster: cluster.Id, Pod: 0})
+				gomega.Expect(err).ShouldNot(gomega.HaveOccurred())
+			}
+		})
+
+		ginkgo.By("Validate that excessive eviction is rejected with 429", func() {
+			err := evict(testutil.PodId{Cluster: 1, Pod: 1})
+			gomega.Expect(err).Should(gomega.SatisfyAll(
+				gomega.HaveOccurred(),
+				gomega.WithTransform(apierrors.IsTooManyRequests, gomega.BeTrue()),
+			))
+		})
+	})
+
 	ginkgo.It("rejects bulk deletions", func(ctx ginkgo.SpecContext) {
 		ginkgo.By("Setup PodProtector and worker pods", func() {
 			fixtures.CreatePodProtectorAndPods(
@@ -232,7 +288,7 @@ var _ = ginkgo.Describe("Webhook", func() {
 							},
 						}
 
-						pprutil.Summarize(config, ppr)
+						pprutil.Summarize(config, ppr, time.Now())
 					},
 				)
 
@@ -0,0 +1,180 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Parses and evaluates schedules in the standard five-field cron syntax.
+package cron
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/kubewharf/podseidon/util/errors"
+)
+
+// A parsed cron schedule, evaluated in UTC.
+type Schedule struct {
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+
+	// If either of dayOfMonth and dayOfWeek is `*`,
+	// a day matches only if both fields match;
+	// otherwise, a day matches if either field matches.
+	dayWildcard bool
+}
+
+type fieldSpec struct {
+	name     string
+	min, max int
+}
+
+var fieldSpecs = [...]fieldSpec{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 7},
+}
+
+// Parses a cron expression with five space-separated fields:
+// minute, hour, day of month, month and day of week.
+//
+// Each field is a comma-separated list of `*`, `N` or `N-M`, optionally followed by `/STEP`.
+// Day of week accepts both 0 and 7 as Sunday.
+func Parse(expr string) (_zero Schedule, _ error) {
+	fields := strings.Fields(expr)
+	if len(fields) != len(fieldSpecs) {
+		return _zero, errors.TagErrorf("FieldCount", "expected %d fields, got %d", len(fieldSpecs), len(fields))
+	}
+
+	var bits [len(fieldSpecs)]uint64
+
+	for i, field := range fields {
+		fieldBits, err := parseField(field, fieldSpecs[i])
+		if err != nil {
+			return _zero, errors.TagWrapf("ParseField", err, "invalid %s field %q", fieldSpecs[i].name, field)
+		}
+
+		bits[i] = fieldBits
+	}
+
+	// Normalize Sunday as 0.
+	if bits[4]&(1<<7) != 0 {
+		bits[4] = bits[4]&^(1<<7) | 1
+	}
+
+	return Schedule{
+		minute:      bits[0],
+		hour:        bits[1],
+		dayOfMonth:  bits[2],
+		month:       bits[3],
+		dayOfWeek:   bits[4],
+		dayWildcard: strings.HasPrefix(fields[2], "*") || strings.HasPrefix(fields[4], "*"),
+	}, nil
+}
+
+func parseField(field string, spec fieldSpec) (uint64, error) {
+	var bits uint64
+
+	for _, item := range strings.Split(field, ",") {
+		rangeExpr, stepExpr, hasStep := strings.Cut(item, "/")
+
+		step := 1
+
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepExpr); err != nil || step <= 0 {
+				return 0, errors.TagErrorf("InvalidStep", "step must be a positive integer")
+			}
+		}
+
+		low, high := spec.min, spec.max
+
+		if rangeExpr != "*" {
+			lowExpr, highExpr, isRange := strings.Cut(rangeExpr, "-")
+
+			var err error
+			if low, err = strconv.Atoi(lowExpr); err != nil {
+				return 0, errors.TagErrorf("InvalidValue", "%q is not an integer", lowExpr)
+			}
+
+			high = low
+			if isRange {
+				if high, err = strconv.Atoi(highExpr); err != nil {
+					return 0, errors.TagErrorf("InvalidValue", "%q is not an integer", highExpr)
+				}
+			} else if hasStep {
+				high = spec.max
+			}
+
+			if low < spec.min || high > spec.max || low > high {
+				return 0, errors.TagErrorf("OutOfRange", "range %d-%d is not within %d-%d", low, high, spec.min, spec.max)
+			}
+		}
+
+		for value := low; value <= high; value += step {
+			bits |= 1 << value
+		}
+	}
+
+	return bits, nil
+}
+
+// Whether the schedule fires at the minute containing t.
+func (schedule Schedule) Matches(t time.Time) bool {
+	t = t.UTC()
+
+	return schedule.matchesDay(t) &&
+		schedule.hour&(1<<t.Hour()) != 0 &&
+		schedule.minute&(1<<t.Minute()) != 0
+}
+
+func (schedule Schedule) matchesDay(t time.Time) bool {
+	if schedule.month&(1<<int(t.Month())) == 0 {
+		return false
+	}
+
+	domMatch := schedule.dayOfMonth&(1<<t.Day()) != 0
+	dowMatch := schedule.dayOfWeek&(1<<int(t.Weekday())) != 0
+
+	if schedule.dayWildcard {
+		return domMatch && dowMatch
+	}
+
+	return domMatch || dowMatch
+}
+
+// Whether the schedule fires at any minute within the interval (from, to].
+func (schedule Schedule) FiresBetween(from, to time.Time) bool {
+	from, to = from.UTC(), to.UTC()
+
+	// Search backwards from `to`, skipping whole days and hours that cannot match.
+	for t := to.Truncate(time.Minute); t.After(from); {
+		switch {
+		case !schedule.matchesDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Add(-time.Minute)
+		case schedule.hour&(1<<t.Hour()) == 0:
+			t = t.Truncate(time.Hour).Add(-time.Minute)
+		case schedule.minute&(1<<t.Minute()) == 0:
+			t = t.Add(-time.Minute)
+		default:
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cron_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubewharf/podseidon/util/cron"
+)
+
+func TestParseInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, expr := range []string{
+		"* * * *",
+		"60 * * * *",
+		"* * 0 * *",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+	} {
+		_, err := cron.Parse(expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+func TestMatches(t *testing.T) {
+	t.Parallel()
+
+	// 2026-01-05 is a Monday.
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, time.January, day, hour, minute, 30, 0, time.UTC)
+	}
+
+	weekdayMorning, err := cron.Parse("0,30 9-17/4 * * 1-5")
+	require.NoError(t, err)
+
+	assert.True(t, weekdayMorning.Matches(at(5, 9, 0)))
+	assert.True(t, weekdayMorning.Matches(at(5, 13, 30)))
+	assert.False(t, weekdayMorning.Matches(at(5, 11, 0)))
+	assert.False(t, weekdayMorning.Matches(at(5, 9, 15)))
+	assert.False(t, weekdayMorning.Matches(at(4, 9, 0)), "Sunday")
+
+	sundayOrFirst, err := cron.Parse("0 0 1 * 7")
+	require.NoError(t, err)
+
+	assert.True(t, sundayOrFirst.Matches(at(1, 0, 0)), "Thursday the 1st")
+	assert.True(t, sundayOrFirst.Matches(at(4, 0, 0)), "Sunday the 4th")
+	assert.False(t, sundayOrFirst.Matches(at(5, 0, 0)))
+}
+
+func TestFiresBetween(t *testing.T) {
+	t.Parallel()
+
+	daily, err := cron.Parse("30 22 * * *")
+	require.NoError(t, err)
+
+	fire := time.Date(2026, time.January, 5, 22, 30, 0, 0, time.UTC)
+
+	assert.True(t, daily.FiresBetween(fire.Add(-time.Minute), fire))
+	assert.True(t, daily.FiresBetween(fire.Add(-time.Hour*2), fire.Add(time.Hour)))
+	assert.False(t, daily.FiresBetween(fire, fire.Add(time.Hour*23)))
+	assert.True(t, daily.FiresBetween(fire, fire.Add(time.Hour*24)))
+	assert.False(t, daily.FiresBetween(fire.Add(-time.Hour*23), fire.Add(-time.Minute)))
+}
@@ -50,6 +50,26 @@ var New = component.Declare(
 				time.Second*5,
 				"Delay period between receiving pod event and triggered aggregation",
 			),
+			StaleCellThreshold: fs.Duration(
+				"stale-cell-threshold",
+				0,
+				"a cell with outstanding admission history is considered stale "+
+					"if it has not observed reflector events for this duration, 0 to disable",
+			),
+			StaleCellPolicy: utilflag.EnumFromMap(map[string]podseidonv1a1.StaleCellPolicy{
+				"zero":      podseidonv1a1.StaleCellPolicyZero,
+				"estimated": podseidonv1a1.StaleCellPolicyEstimated,
+			}).
+				TypeName("policy").
+				Default("estimated").
+				Flag(
+					fs,
+					"stale-cell-policy",
+					"how the available pods of stale cells are counted: "+
+						"estimated deducts outstanding admissions from the aggregated available pods, "+
+						"which denies deletions that would otherwise be retried but leaves the estimated available pods unchanged; "+
+						"zero counts no available pods in the cell",
+				),
 		}
 	},
 	func(util.Empty, *component.DepRequests) util.Empty { return util.Empty{} },
@@ -65,15 +85,19 @@ var New = component.Declare(
 )
 
 type Options struct {
-	MaxConcurrentLag *int32
-	CompactThreshold *int32
-	AggregationRate  *time.Duration
+	MaxConcurrentLag   *int32
+	CompactThreshold   *int32
+	AggregationRate    *time.Duration
+	StaleCellThreshold *time.Duration
+	StaleCellPolicy    *podseidonv1a1.StaleCellPolicy
 }
 
 type Computed struct {
-	MaxConcurrentLag int32
-	CompactThreshold int32
-	AggregationRate  time.Duration
+	MaxConcurrentLag   int32
+	CompactThreshold   int32
+	AggregationRate    time.Duration
+	StaleCellThreshold time.Duration
+	StaleCellPolicy    podseidonv1a1.StaleCellPolicy
 }
 
 func (options *Options) Compute(
@@ -86,6 +110,9 @@ func (options *Options) Compute(
 		CompactThreshold: ptr.Deref(config.CompactThreshold, *options.CompactThreshold),
 		AggregationRate: optional.Map(optional.FromPtr(config.AggregationRateMillis), milliDuration).
 			GetOr(*options.AggregationRate),
+		StaleCellThreshold: optional.Map(optional.FromPtr(config.StaleCellThresholdMillis), milliDuration).
+			GetOr(*options.StaleCellThreshold),
+		StaleCellPolicy: ptr.Deref(config.StaleCellPolicy, *options.StaleCellPolicy),
 	}
 }
 
@@ -0,0 +1,105 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// A consistent hash ring that assigns keys to a set of members,
+// such that adding or removing a member only moves the keys owned by that member.
+package hashring
+
+import (
+	"cmp"
+	"hash/fnv"
+	"slices"
+	"strconv"
+
+	"github.com/kubewharf/podseidon/util/optional"
+)
+
+// An immutable consistent hash ring.
+//
+// Rings constructed from the same set of members always agree on the owner of each key,
+// regardless of the order in which the members are listed.
+type Ring struct {
+	points  []point
+	members []string
+}
+
+type point struct {
+	hash   uint64
+	member string
+}
+
+// Constructs a ring with `virtualNodes` points per member.
+//
+// More virtual nodes distribute keys more evenly at the cost of memory and lookup time.
+func New(members []string, virtualNodes int) *Ring {
+	members = slices.Clone(members)
+	slices.Sort(members)
+	members = slices.Compact(members)
+
+	points := make([]point, 0, len(members)*virtualNodes)
+
+	for _, member := range members {
+		for i := range virtualNodes {
+			points = append(points, point{hash: hash(member + "#" + strconv.Itoa(i)), member: member})
+		}
+	}
+
+	slices.SortFunc(points, func(left, right point) int {
+		// Hash collisions between members are resolved deterministically.
+		return cmp.Or(cmp.Compare(left.hash, right.hash), cmp.Compare(left.member, right.member))
+	})
+
+	return &Ring{points: points, members: members}
+}
+
+// Returns the sorted list of distinct members.
+func (ring *Ring) Members() []string {
+	return ring.members
+}
+
+// Returns the member owning the key, i.e. the member of the first point at or after the hash of the key.
+//
+// Returns None if the ring has no members.
+func (ring *Ring) Owner(key string) optional.Optional[string] {
+	if len(ring.points) == 0 {
+		return optional.None[string]()
+	}
+
+	keyHash := hash(key)
+
+	index, _ := slices.BinarySearchFunc(ring.points, keyHash, func(p point, target uint64) int {
+		return cmp.Compare(p.hash, target)
+	})
+	if index == len(ring.points) {
+		index = 0
+	}
+
+	return optional.Some(ring.points[index].member)
+}
+
+func hash(value string) uint64 {
+	hasher := fnv.New64a()
+	_, _ = hasher.Write([]byte(value))
+
+	// FNV alone distributes short strings with common prefixes poorly,
+	// so the result is passed through the murmur3 finalizer.
+	result := hasher.Sum64()
+	result ^= result >> 33
+	result *= 0xff51afd7ed558ccd
+	result ^= result >> 33
+	result *= 0xc4ceb9fe1a85ec53
+	result ^= result >> 33
+
+	return result
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hashring_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kubewharf/podseidon/util/hashring"
+)
+
+func TestEmptyRing(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, hashring.New(nil, 16).Owner("key").IsNone())
+}
+
+func TestOwnerIndependentOfMemberOrder(t *testing.T) {
+	t.Parallel()
+
+	left := hashring.New([]string{"a", "b", "c"}, 16)
+	right := hashring.New([]string{"c", "a", "b", "a"}, 16)
+
+	assert.Equal(t, []string{"a", "b", "c"}, right.Members())
+
+	for i := range 1000 {
+		key := fmt.Sprintf("key-%d", i)
+		assert.Equal(t, left.Owner(key), right.Owner(key))
+	}
+}
+
+func TestRemovingMemberOnlyMovesItsKeys(t *testing.T) {
+	t.Parallel()
+
+	before := hashring.New([]string{"a", "b", "c", "d"}, 64)
+	after := hashring.New([]string{"a", "b", "d"}, 64)
+
+	owned := map[string]int{}
+
+	for i := range 10000 {
+		key := fmt.Sprintf("key-%d", i)
+
+		beforeOwner := before.Owner(key).MustGet("ring is not empty")
+		afterOwner := after.Owner(key).MustGet("ring is not empty")
+
+		owned[beforeOwner]++
+
+		if beforeOwner != "c" {
+			assert.Equal(t, beforeOwner, afterOwner, "key %q moved from a remaining member", key)
+		} else {
+			assert.NotEqual(t, "c", afterOwner)
+		}
+	}
+
+	for _, member := range []string{"a", "b", "c", "d"} {
+		assert.Greater(t, owned[member], 1500, "member %q owns too few keys", member)
+	}
+}
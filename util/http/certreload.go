@@ -0,0 +1,134 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/kubewharf/podseidon/util/errors"
+	httpobserver "github.com/kubewharf/podseidon/util/http/observer"
+)
+
+// Serves a TLS certificate from a cert/key file pair,
+// reloading it whenever either file changes.
+//
+// The cert and key files are reloaded together and only swapped in if they form a valid pair,
+// so a rotation that updates the files non-atomically (e.g. cert first, then key)
+// keeps serving the old certificate until the pair is consistent again.
+type CertReloader struct {
+	serverName string
+	certFile   string
+	keyFile    string
+	observer   httpobserver.Observer
+
+	current  atomic.Pointer[tls.Certificate]
+	certPem  []byte
+	keyPem   []byte
+	notAfter time.Time
+}
+
+// Loads the initial certificate, failing if it cannot be loaded.
+func NewCertReloader(
+	ctx context.Context,
+	serverName string,
+	certFile, keyFile string,
+	observer httpobserver.Observer,
+) (*CertReloader, error) {
+	//nolint:exhaustruct // atomic and cache fields are populated by reload
+	reloader := &CertReloader{
+		serverName: serverName,
+		certFile:   certFile,
+		keyFile:    keyFile,
+		observer:   observer,
+	}
+
+	if _, err := reloader.Reload(ctx); err != nil {
+		return nil, err
+	}
+
+	return reloader, nil
+}
+
+// Implements `tls.Config.GetCertificate`.
+func (reloader *CertReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return reloader.current.Load(), nil
+}
+
+// Reloads the cert/key files if their contents have changed.
+//
+// Must not be called concurrently.
+func (reloader *CertReloader) Reload(ctx context.Context) (_changed bool, _ error) {
+	changed, err := reloader.tryReload()
+	if changed || err != nil {
+		reloader.observer.CertReload(ctx, httpobserver.CertReload{
+			ServerName: reloader.serverName,
+			NotAfter:   reloader.notAfter,
+			Err:        err,
+		})
+	}
+
+	return changed, err
+}
+
+func (reloader *CertReloader) tryReload() (_changed bool, _ error) {
+	certPem, err := os.ReadFile(reloader.certFile)
+	if err != nil {
+		return false, errors.TagWrapf("ReadCertFile", err, "read TLS certificate file")
+	}
+
+	keyPem, err := os.ReadFile(reloader.keyFile)
+	if err != nil {
+		return false, errors.TagWrapf("ReadKeyFile", err, "read TLS key file")
+	}
+
+	if reloader.current.Load() != nil && bytes.Equal(certPem, reloader.certPem) && bytes.Equal(keyPem, reloader.keyPem) {
+		return false, nil
+	}
+
+	cert, err := tls.X509KeyPair(certPem, keyPem)
+	if err != nil {
+		return false, errors.TagWrapf("ParseKeyPair", err, "parse TLS key pair")
+	}
+
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return false, errors.TagWrapf("ParseLeaf", err, "parse leaf TLS certificate")
+	}
+
+	cert.Leaf = leaf
+
+	reloader.certPem = certPem
+	reloader.keyPem = keyPem
+	reloader.notAfter = leaf.NotAfter
+	reloader.current.Store(&cert)
+
+	return true, nil
+}
+
+// Polls the cert/key files for changes until the context is canceled.
+func (reloader *CertReloader) Run(ctx context.Context, interval time.Duration) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		// errors are reported to the observer, and the previous certificate continues to be served.
+		_, _ = reloader.Reload(ctx)
+	}, interval)
+}
@@ -0,0 +1,144 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package http_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	utilhttp "github.com/kubewharf/podseidon/util/http"
+	httpobserver "github.com/kubewharf/podseidon/util/http/observer"
+)
+
+func generateKeyPair(t *testing.T, notAfter time.Time) (_certPem []byte, _keyPem []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	//nolint:exhaustruct
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "podseidon-test"},
+		NotBefore:    notAfter.Add(-time.Hour),
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	//nolint:exhaustruct
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	//nolint:exhaustruct
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+
+	return certPem, keyPem
+}
+
+func writeFile(t *testing.T, path string, content []byte) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, content, 0o600))
+}
+
+func TestCertReloader(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert"), filepath.Join(dir, "key")
+
+	notAfter1 := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	cert1, key1 := generateKeyPair(t, notAfter1)
+	writeFile(t, certFile, cert1)
+	writeFile(t, keyFile, key1)
+
+	events := []httpobserver.CertReload{}
+	observer := httpobserver.Observer{
+		CertReload: func(_ context.Context, arg httpobserver.CertReload) { events = append(events, arg) },
+	}
+
+	reloader, err := utilhttp.NewCertReloader(ctx, "test", certFile, keyFile, observer)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, notAfter1, events[0].NotAfter)
+
+	served, err := reloader.GetCertificate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, notAfter1, served.Leaf.NotAfter)
+
+	changed, err := reloader.Reload(ctx)
+	require.NoError(t, err)
+	assert.False(t, changed, "unchanged files should not trigger reload")
+	assert.Len(t, events, 1)
+
+	notAfter2 := time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC)
+	cert2, key2 := generateKeyPair(t, notAfter2)
+
+	// Only the certificate has been rotated, the old one should still be served.
+	writeFile(t, certFile, cert2)
+
+	changed, err = reloader.Reload(ctx)
+	require.Error(t, err)
+	assert.False(t, changed)
+	require.Len(t, events, 2)
+	assert.Equal(t, notAfter1, events[1].NotAfter)
+
+	served, err = reloader.GetCertificate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, notAfter1, served.Leaf.NotAfter)
+
+	writeFile(t, keyFile, key2)
+
+	changed, err = reloader.Reload(ctx)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	require.Len(t, events, 3)
+	assert.Equal(t, notAfter2, events[2].NotAfter)
+
+	served, err = reloader.GetCertificate(nil)
+	require.NoError(t, err)
+	assert.Equal(t, notAfter2, served.Leaf.NotAfter)
+}
+
+func TestCertReloaderMissingFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	_, err := utilhttp.NewCertReloader(
+		context.Background(),
+		"test",
+		filepath.Join(dir, "cert"),
+		filepath.Join(dir, "key"),
+		httpobserver.Observer{CertReload: func(context.Context, httpobserver.CertReload) {}},
+	)
+	require.Error(t, err)
+}
@@ -17,6 +17,7 @@ package http
 
 import (
 	"context"
+	"crypto/tls"
 	"flag"
 	"fmt"
 	"net"
@@ -29,6 +30,8 @@ import (
 	"github.com/kubewharf/podseidon/util/component"
 	"github.com/kubewharf/podseidon/util/errors"
 	utilflag "github.com/kubewharf/podseidon/util/flag"
+	httpobserver "github.com/kubewharf/podseidon/util/http/observer"
+	"github.com/kubewharf/podseidon/util/o11y"
 )
 
 // Declares a component that exposes an HTTP server.
@@ -74,6 +77,11 @@ func DeclareServer[Args any, Options any, Deps any, State any, Api any](
 					"",
 					fmt.Sprintf("TLS key file for %s HTTPS server", name),
 				),
+				HttpsReloadInterval: fs.Duration(
+					"https-reload-interval",
+					time.Second*10,
+					fmt.Sprintf("interval to check for changes in the TLS certificate and key files of %s HTTPS server", name),
+				),
 				ReadHeaderTimeout: fs.Duration(
 					"read-header-timeout",
 					time.Second*10,
@@ -82,11 +90,16 @@ func DeclareServer[Args any, Options any, Deps any, State any, Api any](
 				inner: newOptions(args, fs),
 			}
 		},
-		newDeps,
-		func(_ context.Context, args Args, options wrappedOptions[Options], deps Deps) (*wrappedState[State], error) {
+		func(args Args, requests *component.DepRequests) wrappedDeps[Deps] {
+			return wrappedDeps[Deps]{
+				observer: o11y.Request[httpobserver.Observer](requests),
+				inner:    newDeps(args, requests),
+			}
+		},
+		func(ctx context.Context, args Args, options wrappedOptions[Options], deps wrappedDeps[Deps]) (*wrappedState[State], error) {
 			mux := http.NewServeMux()
 
-			innerState, err := registerHandler(args, options.inner, deps, mux)
+			innerState, err := registerHandler(args, options.inner, deps.inner, mux)
 			if err != nil {
 				return nil, err
 			}
@@ -94,20 +107,52 @@ func DeclareServer[Args any, Options any, Deps any, State any, Api any](
 			httpServer := newServer(options.Http, options, mux)
 			httpsServer := newServer(options.Https, options, mux)
 
+			var certReloader *CertReloader
+
+			if httpsServer != nil {
+				certReloader, err = NewCertReloader(
+					ctx,
+					nameFn(args),
+					*options.HttpsCert,
+					*options.HttpsKey,
+					deps.observer.Get(),
+				)
+				if err != nil {
+					return nil, errors.TagWrapf("LoadCert", err, "load TLS certificate for %s HTTPS server", nameFn(args))
+				}
+
+				//nolint:exhaustruct // tls.Config is not intended to be exhausted
+				httpsServer.TLSConfig = &tls.Config{
+					GetCertificate: certReloader.GetCertificate,
+					MinVersion:     tls.VersionTLS12,
+				}
+			}
+
 			return &wrappedState[State]{
-				httpServer:  httpServer,
-				httpsServer: httpsServer,
-				inner:       innerState,
+				httpServer:   httpServer,
+				httpsServer:  httpsServer,
+				certReloader: certReloader,
+				inner:        innerState,
 			}, nil
 		},
-		component.Lifecycle[Args, wrappedOptions[Options], Deps, wrappedState[State]]{
-			Start: func(ctx context.Context, args *Args, options *wrappedOptions[Options], deps *Deps, state *wrappedState[State]) error {
+		component.Lifecycle[Args, wrappedOptions[Options], wrappedDeps[Deps], wrappedState[State]]{
+			Start: func(
+				ctx context.Context,
+				args *Args,
+				options *wrappedOptions[Options],
+				deps *wrappedDeps[Deps],
+				state *wrappedState[State],
+			) error {
 				if lifecycle.Start != nil {
-					if err := lifecycle.Start(ctx, args, &options.inner, deps, state.inner); err != nil {
+					if err := lifecycle.Start(ctx, args, &options.inner, &deps.inner, state.inner); err != nil {
 						return errors.TagWrapf("StartInner", err, "start inner lifecycle")
 					}
 				}
 
+				if state.certReloader != nil {
+					go state.certReloader.Run(ctx, *options.HttpsReloadInterval)
+				}
+
 				if state.httpServer != nil {
 					go func(server *http.Server) {
 						server.BaseContext = func(net.Listener) context.Context { return ctx }
@@ -124,7 +169,8 @@ func DeclareServer[Args any, Options any, Deps any, State any, Api any](
 					go func(server *http.Server) {
 						server.BaseContext = func(net.Listener) context.Context { return ctx }
 
-						err := server.ListenAndServeTLS(*options.HttpsCert, *options.HttpsKey)
+						// certificates are served from TLSConfig.GetCertificate
+						err := server.ListenAndServeTLS("", "")
 						if err != nil && !errors.Is(err, http.ErrServerClosed) {
 							klog.FromContext(ctx).
 								Error(err, fmt.Sprintf("%s HTTPS server error", nameFn(*args)))
@@ -134,7 +180,13 @@ func DeclareServer[Args any, Options any, Deps any, State any, Api any](
 
 				return nil
 			},
-			Join: func(ctx context.Context, args *Args, options *wrappedOptions[Options], deps *Deps, state *wrappedState[State]) error {
+			Join: func(
+				ctx context.Context,
+				args *Args,
+				options *wrappedOptions[Options],
+				deps *wrappedDeps[Deps],
+				state *wrappedState[State],
+			) error {
 				for _, server := range []*http.Server{state.httpServer, state.httpsServer} {
 					if server != nil {
 						if err := server.Shutdown(ctx); err != nil {
@@ -149,7 +201,7 @@ func DeclareServer[Args any, Options any, Deps any, State any, Api any](
 				}
 
 				if lifecycle.Join != nil {
-					return lifecycle.Join(ctx, args, &options.inner, deps, state.inner)
+					return lifecycle.Join(ctx, args, &options.inner, &deps.inner, state.inner)
 				}
 
 				return nil
@@ -162,8 +214,8 @@ func DeclareServer[Args any, Options any, Deps any, State any, Api any](
 				return nil
 			},
 		},
-		func(d *component.Data[Args, wrappedOptions[Options], Deps, wrappedState[State]]) Api {
-			return api(d.Args, d.Options.inner, d.Deps, d.State.inner)
+		func(d *component.Data[Args, wrappedOptions[Options], wrappedDeps[Deps], wrappedState[State]]) Api {
+			return api(d.Args, d.Options.inner, d.Deps.inner, d.State.inner)
 		},
 	)
 }
@@ -226,18 +278,26 @@ func newServer[Inner any](
 type wrappedOptions[Inner any] struct {
 	Http serverOptions
 
-	Https     serverOptions
-	HttpsCert *string
-	HttpsKey  *string
+	Https               serverOptions
+	HttpsCert           *string
+	HttpsKey            *string
+	HttpsReloadInterval *time.Duration
 
 	ReadHeaderTimeout *time.Duration
 
 	inner Inner
 }
 
+type wrappedDeps[Inner any] struct {
+	observer component.Dep[httpobserver.Observer]
+
+	inner Inner
+}
+
 type wrappedState[Inner any] struct {
-	httpServer  *http.Server
-	httpsServer *http.Server
+	httpServer   *http.Server
+	httpsServer  *http.Server
+	certReloader *CertReloader
 
 	inner *Inner
 }
@@ -0,0 +1,69 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpobserver
+
+import (
+	"context"
+
+	"github.com/kubewharf/podseidon/util/component"
+	"github.com/kubewharf/podseidon/util/errors"
+	"github.com/kubewharf/podseidon/util/o11y"
+	"github.com/kubewharf/podseidon/util/o11y/metrics"
+)
+
+func ProvideMetrics() component.Declared[Observer] {
+	return o11y.Provide(
+		metrics.MakeObserverDeps,
+		func(deps metrics.ObserverDeps) Observer {
+			type serverTags struct {
+				Server string
+			}
+
+			type reloadTags struct {
+				Server string
+				Error  string
+			}
+
+			expiryHandle := metrics.Register(
+				deps.Registry(),
+				"http_tls_cert_expiry",
+				"Unix timestamp in seconds at which the currently served TLS certificate expires.",
+				metrics.Int64Gauge(),
+				metrics.NewReflectTags[serverTags](),
+			)
+
+			reloadHandle := metrics.Register(
+				deps.Registry(),
+				"http_tls_cert_reload",
+				"Number of TLS certificate reload attempts after the files changed.",
+				metrics.IntCounter(),
+				metrics.NewReflectTags[reloadTags](),
+			)
+
+			return Observer{
+				CertReload: func(_ context.Context, arg CertReload) {
+					reloadHandle.Emit(1, reloadTags{
+						Server: arg.ServerName,
+						Error:  errors.SerializeTags(arg.Err),
+					})
+
+					if !arg.NotAfter.IsZero() {
+						expiryHandle.Emit(arg.NotAfter.Unix(), serverTags{Server: arg.ServerName})
+					}
+				},
+			}
+		},
+	)
+}
@@ -0,0 +1,42 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpobserver
+
+import (
+	"time"
+
+	"github.com/kubewharf/podseidon/util/component"
+	"github.com/kubewharf/podseidon/util/o11y"
+)
+
+var Provide = component.RequireDeps(
+	component.RequireDep(ProvideLogging()),
+	component.RequireDep(ProvideMetrics()),
+)
+
+type Observer struct {
+	CertReload o11y.ObserveFunc[CertReload]
+}
+
+func (Observer) ComponentName() string { return "http" }
+
+func (observer Observer) Join(other Observer) Observer { return o11y.ReflectJoin(observer, other) }
+
+type CertReload struct {
+	ServerName string
+	// Expiry time of the leaf certificate currently served, which is the old one if Err is non-nil.
+	NotAfter time.Time
+	Err      error
+}
@@ -21,6 +21,8 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes"
 	kubernetesfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
@@ -133,10 +135,20 @@ var NewClient = component.Declare(
 			)
 		}
 
+		dynamicClient, err := dynamic.NewForConfig(restConfig)
+		if err != nil {
+			return nil, errors.TagWrapf(
+				"NewClientSet",
+				err,
+				"create new dynamic client from config",
+			)
+		}
+
 		return &ClientState{
 			restConfig:         restConfig,
 			kubeClientSet:      kubeClientSet,
 			podseidonClientSet: podseidonClientSet,
+			dynamicClient:      dynamicClient,
 		}, nil
 	},
 	component.Lifecycle[ClientArgs, ClientOptions, ClientDeps, ClientState]{
@@ -177,6 +189,7 @@ type ClientState struct {
 
 	kubeClientSet      kubernetes.Interface
 	podseidonClientSet podseidonclient.Interface
+	dynamicClient      dynamic.Interface
 }
 
 // This method should only be used for constructing extension client sets for a cluster.
@@ -193,6 +206,11 @@ func (client *Client) PodseidonClientSet() podseidonclient.Interface {
 	return client.state.podseidonClientSet
 }
 
+// Accesses arbitrary resources in the cluster in unstructured form.
+func (client *Client) DynamicClient() dynamic.Interface {
+	return client.state.dynamicClient
+}
+
 func (client *Client) TargetNamespace() string {
 	return *client.targetNs
 }
@@ -218,6 +236,7 @@ func MockClient(objects ...runtime.Object) *Client {
 			restConfig:         new(rest.Config),
 			kubeClientSet:      kubernetesfake.NewSimpleClientset(nativeObjects...),
 			podseidonClientSet: podseidonfakeclient.NewSimpleClientset(podseidonObjects...),
+			dynamicClient:      dynamicfake.NewSimpleDynamicClient(runtime.NewScheme()),
 		},
 	}
 }
@@ -19,6 +19,7 @@ import (
 	"flag"
 	"fmt"
 
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	kubeinformers "k8s.io/client-go/informers"
 
 	podseidoninformers "github.com/kubewharf/podseidon/client/informers/externalversions"
@@ -187,6 +188,27 @@ func PodseidonInformers(
 	}
 }
 
+// Pass to `NewInformers` to request a shared informer factory for arbitrary resources in unstructured form
+// corresponding to the specified cluster.
+func DynamicInformers(
+	clusterName ClusterName,
+	phase InformerPhase,
+	elector optional.Optional[ElectorArgs],
+) InformersArgs[*Client, dynamicinformer.DynamicSharedInformerFactory] {
+	return InformersArgs[*Client, dynamicinformer.DynamicSharedInformerFactory]{
+		ClusterName: clusterName,
+		Phase:       phase,
+		Elector:     elector,
+		ClientDep: func() component.Declared[*Client] {
+			return NewClient(ClientArgs{ClusterName: clusterName})
+		},
+		InformerSetName: "dynamic",
+		InformerSetCtor: func(client *Client) dynamicinformer.DynamicSharedInformerFactory {
+			return dynamicinformer.NewDynamicSharedInformerFactory(client.DynamicClient(), 0)
+		},
+	}
+}
+
 type InformersOptions struct{}
 
 type InformersDeps[ClientT any] struct {
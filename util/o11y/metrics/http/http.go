@@ -12,7 +12,10 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package metrics
+// Serves the metrics registry over HTTP.
+//
+// This is separate from the metrics package since HTTP servers themselves report metrics.
+package metricshttp
 
 import (
 	"flag"
@@ -22,6 +25,7 @@ import (
 
 	"github.com/kubewharf/podseidon/util/component"
 	utilhttp "github.com/kubewharf/podseidon/util/http"
+	"github.com/kubewharf/podseidon/util/o11y/metrics"
 	"github.com/kubewharf/podseidon/util/util"
 )
 
@@ -30,38 +34,40 @@ const (
 	defaultHttpsPort uint16 = 9443
 )
 
-var NewHttp = utilhttp.DeclareServer(
-	func(HttpArgs) string { return "prometheus-http" },
+var New = utilhttp.DeclareServer(
+	func(Args) string { return "prometheus-http" },
 	defaultHttpPort,
 	defaultHttpsPort,
-	func(HttpArgs, *flag.FlagSet) HttpOptions { return HttpOptions{} },
-	func(_ HttpArgs, requests *component.DepRequests) httpDeps {
+	func(Args, *flag.FlagSet) Options { return Options{} },
+	func(_ Args, requests *component.DepRequests) httpDeps {
 		return httpDeps{
-			comp: component.DepPtr(requests, newRegistry()),
+			observerDeps: metrics.MakeObserverDeps(requests),
 		}
 	},
-	func(_ HttpArgs, _ HttpOptions, deps httpDeps, mux *http.ServeMux) (*httpState, error) {
+	func(_ Args, _ Options, deps httpDeps, mux *http.ServeMux) (*httpState, error) {
+		registry := deps.observerDeps.Registry().Prometheus
+
 		//nolint:exhaustruct
-		mux.Handle("/", promhttp.HandlerFor(deps.comp.Get().PrometheusRegistry, promhttp.HandlerOpts{
-			Registry: deps.comp.Get().PrometheusRegistry,
+		mux.Handle("/", promhttp.HandlerFor(registry, promhttp.HandlerOpts{
+			Registry: registry,
 		}))
 
 		return &httpState{}, nil
 	},
-	component.Lifecycle[HttpArgs, HttpOptions, httpDeps, httpState]{
+	component.Lifecycle[Args, Options, httpDeps, httpState]{
 		Start:        nil,
 		Join:         nil,
 		HealthChecks: nil,
 	},
-	func(HttpArgs, HttpOptions, httpDeps, *httpState) util.Empty { return util.Empty{} },
+	func(Args, Options, httpDeps, *httpState) util.Empty { return util.Empty{} },
 )
 
-type HttpArgs struct{}
+type Args struct{}
 
-type HttpOptions struct{}
+type Options struct{}
 
 type httpDeps struct {
-	comp component.Dep[*registryState]
+	observerDeps metrics.ObserverDeps
 }
 
 type httpState struct{}
@@ -0,0 +1,67 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pprutil
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
+)
+
+// Whether the key refers to a ClusterPodProtector.
+//
+// ClusterPodProtectors are handled as PodProtector views with an empty namespace throughout the pipeline.
+func (key PodProtectorKey) IsClusterScoped() bool {
+	return key.Namespace == ""
+}
+
+// Describes the referenced object in user-facing messages.
+func (key PodProtectorKey) DisplayName() string {
+	if key.IsClusterScoped() {
+		return fmt.Sprintf("ClusterPodProtector %s", key.Name)
+	}
+
+	return fmt.Sprintf("PodProtector %s/%s", key.Namespace, key.Name)
+}
+
+// Returns a PodProtector view of a ClusterPodProtector.
+//
+// The view shares its fields with the original object and must be deep-copied before mutation.
+// The namespace selector is not retained in the view.
+func ClusterPodProtectorView(cppr *podseidonv1a1.ClusterPodProtector) *podseidonv1a1.PodProtector {
+	return &podseidonv1a1.PodProtector{
+		TypeMeta:   metav1.TypeMeta{},
+		ObjectMeta: cppr.ObjectMeta,
+		Spec:       cppr.Spec.PodProtectorSpec,
+		Status:     cppr.Status,
+	}
+}
+
+// Converts a PodProtector view back to a ClusterPodProtector for status updates.
+//
+// The namespace selector is left empty since the status subresource ignores changes to the spec.
+func clusterPodProtectorFromView(ppr *podseidonv1a1.PodProtector) *podseidonv1a1.ClusterPodProtector {
+	return &podseidonv1a1.ClusterPodProtector{
+		TypeMeta:   metav1.TypeMeta{},
+		ObjectMeta: ppr.ObjectMeta,
+		Spec: podseidonv1a1.ClusterPodProtectorSpec{
+			NamespaceSelector: metav1.LabelSelector{},
+			PodProtectorSpec:  ppr.Spec,
+		},
+		Status: ppr.Status,
+	}
+}
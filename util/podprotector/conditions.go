@@ -0,0 +1,138 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pprutil
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
+
+	"github.com/kubewharf/podseidon/util/defaultconfig"
+	"github.com/kubewharf/podseidon/util/optional"
+)
+
+type ConditionThresholds struct {
+	// History is lagging if MaxLatencyMillis in the summary exceeds this duration.
+	HistoryLag time.Duration
+}
+
+// Updates the standard conditions and observedGeneration of a summarized PodProtector.
+// Must be called after Summarize with the same config,
+// so that the StaleCell condition agrees with the stale cells counted in the summary.
+//
+// Returns whether the status has changed,
+// and the delay after which a cell that is currently fresh would become stale,
+// since StaleCell is the only condition that may change without any status update.
+// The caller should re-evaluate the conditions after this delay.
+func UpdateConditions(
+	config defaultconfig.Computed,
+	ppr *podseidonv1a1.PodProtector,
+	now time.Time,
+	thresholds ConditionThresholds,
+) (_changed bool, _recheckAfter optional.Optional[time.Duration]) {
+	changed := ppr.Status.ObservedGeneration != ppr.Generation
+	ppr.Status.ObservedGeneration = ppr.Generation
+
+	set := func(conditionType string, status bool, reason string, message string) {
+		conditionStatus := metav1.ConditionFalse
+		if status {
+			conditionStatus = metav1.ConditionTrue
+		}
+
+		if meta.SetStatusCondition(&ppr.Status.Conditions, metav1.Condition{
+			Type:               conditionType,
+			Status:             conditionStatus,
+			ObservedGeneration: ppr.Generation,
+			LastTransitionTime: metav1.Time{Time: now},
+			Reason:             reason,
+			Message:            message,
+		}) {
+			changed = true
+		}
+	}
+
+	summary := ppr.Status.Summary
+
+	aggregatedCells := 0
+	staleCells := []string{}
+	recheckAfter := optional.None[time.Duration]()
+
+	for _, cell := range ppr.Status.Cells {
+		if cell.Aggregation.LastEventTime.IsZero() {
+			continue
+		}
+
+		aggregatedCells++
+
+		if IsCellStale(&cell, now, config.StaleCellThreshold) {
+			staleCells = append(staleCells, cell.CellId)
+		} else if config.StaleCellThreshold > 0 && len(cell.History.Buckets) > 0 {
+			// +1ns since IsCellStale requires the threshold to be strictly exceeded.
+			staleAfter := cell.Aggregation.LastEventTime.Add(config.StaleCellThreshold).Sub(now) + time.Nanosecond
+			recheckAfter = optional.Some(min(recheckAfter.GetOr(staleAfter), staleAfter))
+		}
+	}
+
+	if aggregatedCells > 0 {
+		set(podseidonv1a1.ConditionTypeAggregated, true, "CellsAggregated",
+			fmt.Sprintf("%d of %d cells have reported aggregation", aggregatedCells, len(ppr.Status.Cells)))
+	} else {
+		set(podseidonv1a1.ConditionTypeAggregated, false, "NoAggregation", "no cells have reported aggregation")
+	}
+
+	if summary.AggregatedAvailable <= summary.MinAvailable {
+		set(podseidonv1a1.ConditionTypeAtRisk, true, "InsufficientAvailable",
+			fmt.Sprintf("%d pods available, %d required", summary.AggregatedAvailable, summary.MinAvailable))
+	} else {
+		set(podseidonv1a1.ConditionTypeAtRisk, false, "SufficientAvailable",
+			fmt.Sprintf("%d pods available, %d required", summary.AggregatedAvailable, summary.MinAvailable))
+	}
+
+	if len(staleCells) > 0 {
+		set(podseidonv1a1.ConditionTypeStaleCell, true, "CellsStale",
+			fmt.Sprintf("cells with stale aggregation: %s", strings.Join(staleCells, ", ")))
+	} else {
+		set(podseidonv1a1.ConditionTypeStaleCell, false, "AllCellsFresh", "")
+	}
+
+	if time.Duration(summary.MaxLatencyMillis)*time.Millisecond > thresholds.HistoryLag {
+		set(podseidonv1a1.ConditionTypeHistoryLagging, true, "HistoryLagging",
+			fmt.Sprintf("aggregation lags behind admission history by %dms", summary.MaxLatencyMillis))
+	} else {
+		set(podseidonv1a1.ConditionTypeHistoryLagging, false, "HistoryCaughtUp", "")
+	}
+
+	invalidWindows := []string{}
+
+	for i := range ppr.Spec.Windows {
+		if _, err := IsWindowActive(&ppr.Spec.Windows[i], now); err != nil {
+			invalidWindows = append(invalidWindows, fmt.Sprintf("%s: %v", ppr.Spec.Windows[i].Name, err))
+		}
+	}
+
+	if len(invalidWindows) > 0 {
+		set(podseidonv1a1.ConditionTypeInvalidWindow, true, "WindowsInvalid",
+			fmt.Sprintf("windows that never take effect: %s", strings.Join(invalidWindows, "; ")))
+	} else {
+		set(podseidonv1a1.ConditionTypeInvalidWindow, false, "AllWindowsValid", "")
+	}
+
+	return changed, recheckAfter
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pprutil
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"k8s.io/utils/ptr"
+
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
+
+	"github.com/kubewharf/podseidon/util/optional"
+)
+
+// Computes the quota imposed by the disruption rate of the PodProtector at the given time.
+// Returns None if the PodProtector does not specify a disruption rate.
+//
+// Exhausting the disruption rate never results in a denial,
+// since the quota is always restored after the window elapses.
+func ComputeDisruptionRateQuota(ppr *podseidonv1a1.PodProtector, now time.Time) optional.Optional[DisruptionQuota] {
+	rate := ppr.Spec.DisruptionRate
+	if rate == nil {
+		return optional.None[DisruptionQuota]()
+	}
+
+	admitted := int32(0)
+	for _, entry := range admissionsInWindow(ppr, now) {
+		admitted += entry.count
+	}
+
+	return optional.Some(DisruptionQuota{
+		Cleared:      max(rate.MaxDisruptions-admitted, 0),
+		Transitional: math.MaxInt32,
+	})
+}
+
+// Returns the duration after which the disruption rate of the PodProtector admits another disruption,
+// or None if the disruption rate is not exhausted at the given time.
+func DisruptionRateRetryAfter(ppr *podseidonv1a1.PodProtector, now time.Time) optional.Optional[time.Duration] {
+	rate := ppr.Spec.DisruptionRate
+	if rate == nil {
+		return optional.None[time.Duration]()
+	}
+
+	entries := admissionsInWindow(ppr, now)
+
+	admitted := int32(0)
+	for _, entry := range entries {
+		admitted += entry.count
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].expiry.Before(entries[j].expiry) })
+
+	for _, entry := range entries {
+		if admitted < rate.MaxDisruptions {
+			break
+		}
+
+		admitted -= entry.count
+		if admitted < rate.MaxDisruptions {
+			return optional.Some(entry.expiry.Sub(now))
+		}
+	}
+
+	return optional.None[time.Duration]()
+}
+
+type windowEntry struct {
+	// The time at which this entry leaves the window.
+	expiry time.Time
+	count  int32
+}
+
+// Lists the admissions within the disruption rate window ending at now,
+// including both outstanding and observed admission history.
+// A compacted bucket is counted until its end time leaves the window.
+// Since compaction discards the times of the individual admissions,
+// the admissions in a compacted bucket that started before the window are still counted,
+// i.e. the disruption rate is enforced more strictly than configured after compaction.
+func admissionsInWindow(ppr *podseidonv1a1.PodProtector, now time.Time) []windowEntry {
+	window := ppr.Spec.DisruptionRate.Window.Duration
+	windowStart := now.Add(-window)
+
+	entries := []windowEntry{}
+
+	for _, cell := range ppr.Status.Cells {
+		for _, buckets := range [][]podseidonv1a1.PodProtectorAdmissionBucket{cell.History.Buckets, cell.History.Observed} {
+			for _, bucket := range buckets {
+				endTime := bucket.StartTime.Time
+				if bucket.EndTime != nil {
+					endTime = bucket.EndTime.Time
+				}
+
+				if endTime.After(windowStart) {
+					entries = append(entries, windowEntry{
+						expiry: endTime.Add(window),
+						count:  ptr.Deref(bucket.Counter, 1),
+					})
+				}
+			}
+		}
+	}
+
+	return entries
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pprutil
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	podseidon "github.com/kubewharf/podseidon/apis"
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
+
+	"github.com/kubewharf/podseidon/util/defaultconfig"
+	"github.com/kubewharf/podseidon/util/optional"
+	"github.com/kubewharf/podseidon/util/util"
+)
+
+// Identifies a PodProtectorGroup.
+type GroupKey struct {
+	SourceName SourceName
+	types.NamespacedName
+}
+
+// Describes the referenced object in user-facing messages.
+func (key GroupKey) DisplayName() string {
+	return fmt.Sprintf("PodProtectorGroup %s/%s", key.Namespace, key.Name)
+}
+
+// Returns the group that the PodProtector is a member of, if any.
+// ClusterPodProtectors cannot be members of a group.
+func GroupOf(key PodProtectorKey, ppr *podseidonv1a1.PodProtector) optional.Optional[GroupKey] {
+	groupName := ppr.Labels[podseidon.PprGroupLabel]
+	if key.IsClusterScoped() || groupName == "" {
+		return optional.None[GroupKey]()
+	}
+
+	return optional.Some(GroupKey{
+		SourceName:     key.SourceName,
+		NamespacedName: types.NamespacedName{Namespace: key.Namespace, Name: groupName},
+	})
+}
+
+// Removes the admissions of a group that are already reflected in the aggregation of their member,
+// or whose member no longer exists.
+// Returns whether any admission was removed.
+func PruneGroupAdmissions(group *podseidonv1a1.PodProtectorGroup, members []*podseidonv1a1.PodProtector) bool {
+	before := len(group.Status.Admissions)
+
+	util.DrainSliceOrdered(&group.Status.Admissions, func(admission podseidonv1a1.PodProtectorGroupAdmission) bool {
+		return isGroupAdmissionOutstanding(admission, members)
+	})
+
+	return len(group.Status.Admissions) != before
+}
+
+// An admission is outstanding until the aggregator of its member in its cell
+// has received an event no earlier than the admission,
+// consistent with how aggregator removes admission buckets from the member.
+func isGroupAdmissionOutstanding(
+	admission podseidonv1a1.PodProtectorGroupAdmission,
+	members []*podseidonv1a1.PodProtector,
+) bool {
+	memberIndex := util.FindInSliceWith(
+		members,
+		func(member *podseidonv1a1.PodProtector) bool { return member.Name == admission.PodProtector },
+	)
+	if memberIndex == -1 {
+		return false
+	}
+
+	cells := members[memberIndex].Status.Cells
+
+	cellIndex := util.FindInSliceWith(
+		cells,
+		func(cell podseidonv1a1.PodProtectorCellStatus) bool { return cell.CellId == admission.CellId },
+	)
+	if cellIndex == -1 {
+		return true
+	}
+
+	return !admission.StartTime.Time.Before(cells[cellIndex].Aggregation.LastEventTime.Time)
+}
+
+// Summarizes the availability of a group from the aggregation of its members and its outstanding admissions.
+//
+// Pods selected by multiple members are counted once per member.
+// The admission history of the group must be pruned with PruneGroupAdmissions first.
+func SummarizeGroup(
+	group *podseidonv1a1.PodProtectorGroup,
+	members []*podseidonv1a1.PodProtector,
+) podseidonv1a1.PodProtectorStatusSummary {
+	//nolint:exhaustruct // per-cell fields are not meaningful for groups
+	summary := podseidonv1a1.PodProtectorStatusSummary{}
+
+	for _, member := range members {
+		summary.Total += member.Status.Summary.Total
+		summary.AggregatedAvailable += member.Status.Summary.AggregatedAvailable
+	}
+
+	summary.MinAvailable = resolveMinAvailable(group.Spec.MinAvailable, group.Spec.MaxUnavailable, summary.Total)
+	summary.EstimatedAvailable = summary.AggregatedAvailable - int32(len(group.Status.Admissions))
+
+	return summary
+}
+
+// Computes the disruption quota of a group from the summary returned by SummarizeGroup.
+func ComputeGroupDisruptionQuota(
+	group *podseidonv1a1.PodProtectorGroup,
+	members []*podseidonv1a1.PodProtector,
+) DisruptionQuota {
+	summary := SummarizeGroup(group, members)
+
+	//nolint:exhaustruct // only the fields read by ComputeDisruptionQuota are relevant
+	return ComputeDisruptionQuota(summary.MinAvailable, defaultconfig.Computed{MaxConcurrentLag: 0}, summary)
+}
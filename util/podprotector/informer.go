@@ -54,6 +54,19 @@ func NewSelectorIndex() SelectorIndex {
 	)
 }
 
+// Indexes the pod selectors of ClusterPodProtectors by name.
+type ClusterSelectorIndex = *labelindex.Locked[
+	string, metav1.LabelSelector, map[string]string, error, util.Empty,
+	*labelindex.Selectors[string],
+]
+
+func NewClusterSelectorIndex() ClusterSelectorIndex {
+	return labelindex.NewLocked(
+		labelindex.NewSelectors[string](),
+		labelindex.EmptyErrAdapter{},
+	)
+}
+
 func SetupPprInformer(
 	ctx context.Context,
 	pprInformer podseidonv1a1informers.PodProtectorInformer,
@@ -96,6 +109,48 @@ func SetupPprInformer(
 	return nil
 }
 
+func SetupClusterPprInformer(
+	ctx context.Context,
+	cpprInformer podseidonv1a1informers.ClusterPodProtectorInformer,
+	postHandler func(types.NamespacedName),
+	selectorIndex ClusterSelectorIndex,
+	observeStartEnqueue o11y.ObserveScopeFunc[types.NamespacedName],
+	observeEndEnqueue func(context.Context),
+	observeEnqueueError func(context.Context, types.NamespacedName, error),
+) error {
+	handler := func(cppr *podseidonv1a1.ClusterPodProtector, stillPresent bool) {
+		nsName := types.NamespacedName{Namespace: "", Name: cppr.Name}
+
+		ctx, cancelFunc := observeStartEnqueue(ctx, nsName)
+		defer cancelFunc()
+
+		defer observeEndEnqueue(ctx)
+
+		if stillPresent {
+			if err := selectorIndex.Track(cppr.Name, GetAggregationSelector(ClusterPodProtectorView(cppr))); err != nil {
+				observeEnqueueError(ctx, nsName, err)
+			}
+		} else {
+			selectorIndex.Untrack(cppr.Name)
+		}
+
+		postHandler(nsName)
+	}
+
+	_, err := cpprInformer.
+		Informer().
+		AddEventHandler(kube.GenericEventHandlerWithStaleState(handler))
+	if err != nil {
+		return errors.TagWrapf(
+			"AddClusterPodProtectorEventHandler",
+			err,
+			"add event handler to ClusterPodProtector informer",
+		)
+	}
+
+	return nil
+}
+
 type IndexedInformer interface {
 	// Register a function that gets called when a PodProtector has been received,
 	// after the index has been updated for the object.
@@ -108,8 +163,24 @@ type IndexedInformer interface {
 	HasSynced() bool
 
 	// Gets a PodProtector by name if it exists.
+	//
+	// ClusterPodProtectors are returned as PodProtector views for cluster-scoped keys.
 	Get(nsName PodProtectorKey) (optional.Optional[*podseidonv1a1.PodProtector], error)
 
-	// Queries for PodProtector under the namespace matching the label selector.
+	// Queries for PodProtector under the namespace matching the label selector,
+	// including ClusterPodProtectors whose namespace selector matches the namespace.
 	Query(namespace string, labels map[string]string) []PodProtectorKey
+
+	// Lists the namespaces in which pods are protected by the referenced object.
+	//
+	// Returns the namespace of the key for namespaced PodProtectors,
+	// or the namespaces matching the namespace selector for ClusterPodProtectors.
+	MatchingNamespaces(key PodProtectorKey) ([]string, error)
+
+	// Gets a PodProtectorGroup by name if it exists.
+	GetGroup(key GroupKey) (optional.Optional[*podseidonv1a1.PodProtectorGroup], error)
+
+	// Lists the member PodProtectors of a group.
+	// Returns an empty list if the group does not exist.
+	ListGroupMembers(key GroupKey) ([]*podseidonv1a1.PodProtector, error)
 }
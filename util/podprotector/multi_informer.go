@@ -18,17 +18,27 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"maps"
 	"sync/atomic"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
+	kubeinformers "k8s.io/client-go/informers"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/ptr"
 
+	podseidon "github.com/kubewharf/podseidon/apis"
 	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
 	podseidonclient "github.com/kubewharf/podseidon/client/clientset/versioned"
 	podseidoninformers "github.com/kubewharf/podseidon/client/informers/externalversions"
+	podseidonv1a1informers "github.com/kubewharf/podseidon/client/informers/externalversions/apis/v1alpha1"
 	podseidonv1a1listers "github.com/kubewharf/podseidon/client/listers/apis/v1alpha1"
 
 	"github.com/kubewharf/podseidon/util/component"
@@ -163,10 +173,17 @@ type SourceProvider interface {
 	// The aggregate indexed informer is only considered synced when both conditions are met:
 	// 1. The Watch() channel has transferred at least one map.
 	// 2. The informers of all sources in the latest transferred map from the channel are simultaneously synced.
+	//
+	// Each SourceDesc must set PodseidonClient.
+	// NativeClient must also be set for ClusterPodProtectors in the source to take effect.
 	Watch(ctx context.Context) <-chan map[SourceName]SourceDesc
 
 	// Updates the status of a PodProtector object as received from `sourceName`.
+	// A PodProtector with an empty namespace is a view of a ClusterPodProtector.
 	UpdateStatus(ctx context.Context, sourceName SourceName, ppr *podseidonv1a1.PodProtector) error
+
+	// Updates the status of a PodProtectorGroup object as received from `sourceName`.
+	UpdateGroupStatus(ctx context.Context, sourceName SourceName, group *podseidonv1a1.PodProtectorGroup) error
 }
 
 // Identifies a source for PodProtector.
@@ -179,6 +196,13 @@ type SourceDesc struct {
 	// Only List and Watch methods of the interface are used.
 	// Updates will go through [SourceProvider.UpdateStatus] instead.
 	PodseidonClient podseidonclient.Interface
+
+	// A client to access the Namespaces in the cluster,
+	// used to evaluate the namespace selectors of ClusterPodProtectors.
+	//
+	// Only List and Watch methods of the interface are used.
+	// If nil, ClusterPodProtectors in this source are ignored.
+	NativeClient kubernetes.Interface
 }
 
 type informerState struct {
@@ -191,9 +215,16 @@ type informerState struct {
 
 type sourceState struct {
 	lister        podseidonv1a1listers.PodProtectorLister
-	hasSynced     cache.InformerSynced
+	hasSynced     []cache.InformerSynced
 	selectorIndex SelectorIndex
-	cancelFunc    context.CancelFunc
+
+	clusterLister        podseidonv1a1listers.ClusterPodProtectorLister
+	clusterSelectorIndex ClusterSelectorIndex
+	namespaceLister      corev1listers.NamespaceLister
+
+	groupLister podseidonv1a1listers.PodProtectorGroupLister
+
+	cancelFunc context.CancelFunc
 }
 
 func (state *informerState) updateSourceList(
@@ -280,6 +311,15 @@ func startSourceInformer(
 	pprInformer := informerFactory.Podseidon().V1alpha1().PodProtectors()
 	selectorIndex := NewSelectorIndex()
 
+	cpprInformer := informerFactory.Podseidon().V1alpha1().ClusterPodProtectors()
+	clusterSelectorIndex := NewClusterSelectorIndex()
+
+	groupInformer := informerFactory.Podseidon().V1alpha1().PodProtectorGroups()
+
+	// Sources without a native client cannot evaluate namespace selectors,
+	// so ClusterPodProtectors are not watched at all.
+	clusterSupported := desc.NativeClient != nil
+
 	ctx, cancelFunc := context.WithCancel(ctx)
 	informerStarted := false
 
@@ -289,45 +329,132 @@ func startSourceInformer(
 		}
 	}()
 
+	runPostHandlers := func(nn types.NamespacedName) {
+		for _, postHandler := range postHandlers {
+			postHandler(PodProtectorKey{
+				SourceName:     sourceName,
+				NamespacedName: nn,
+			})
+		}
+	}
+
+	observeStartEnqueue := func(ctx context.Context, nsName types.NamespacedName) (context.Context, context.CancelFunc) {
+		return obs.StartHandleEvent(ctx, nsName)
+	}
+	observeEndEnqueue := func(ctx context.Context) {
+		obs.EndHandleEvent(ctx, util.Empty{})
+	}
+	observeEnqueueError := func(ctx context.Context, nsName types.NamespacedName, err error) {
+		obs.HandleEventError(
+			ctx,
+			observer.HandleEventError{Namespace: nsName.Namespace, Name: nsName.Name, Err: err},
+		)
+	}
+
 	if err := SetupPprInformer(
 		ctx, pprInformer,
-		func(nn types.NamespacedName) {
-			for _, postHandler := range postHandlers {
-				postHandler(PodProtectorKey{
-					SourceName:     sourceName,
-					NamespacedName: nn,
-				})
-			}
-		},
+		runPostHandlers,
 		selectorIndex,
-		func(ctx context.Context, nsName types.NamespacedName) (context.Context, context.CancelFunc) {
-			return obs.StartHandleEvent(ctx, nsName)
-		},
-		func(ctx context.Context) {
-			obs.EndHandleEvent(ctx, util.Empty{})
-		},
-		func(ctx context.Context, nsName types.NamespacedName, err error) {
-			obs.HandleEventError(
-				ctx,
-				observer.HandleEventError{Namespace: nsName.Namespace, Name: nsName.Name, Err: err},
-			)
-		},
+		observeStartEnqueue, observeEndEnqueue, observeEnqueueError,
 	); err != nil {
 		return nil, errors.TagWrapf("SetupPprInformer", err, "create ppr informer for cell")
 	}
 
+	// Groups do not have event handlers, so the informer must be instantiated explicitly before starting the factory.
+	groupLister := groupInformer.Lister()
+
+	state := &sourceState{
+		lister: pprInformer.Lister(),
+		hasSynced: []cache.InformerSynced{
+			pprInformer.Informer().HasSynced,
+			groupInformer.Informer().HasSynced,
+		},
+		selectorIndex:        selectorIndex,
+		clusterLister:        nil,
+		clusterSelectorIndex: clusterSelectorIndex,
+		namespaceLister:      nil,
+		groupLister:          groupLister,
+		cancelFunc:           cancelFunc,
+	}
+
+	startNativeInformers := func(<-chan struct{}) {}
+
+	if clusterSupported {
+		nativeInformerFactory := kubeinformers.NewSharedInformerFactory(desc.NativeClient, 0)
+		namespaceInformer := nativeInformerFactory.Core().V1().Namespaces()
+
+		if err := setupClusterPprSupport(
+			ctx, cpprInformer, namespaceInformer,
+			runPostHandlers,
+			clusterSelectorIndex,
+			observeStartEnqueue, observeEndEnqueue, observeEnqueueError,
+		); err != nil {
+			return nil, err
+		}
+
+		state.hasSynced = append(state.hasSynced, cpprInformer.Informer().HasSynced, namespaceInformer.Informer().HasSynced)
+		state.clusterLister = cpprInformer.Lister()
+		state.namespaceLister = namespaceInformer.Lister()
+		startNativeInformers = nativeInformerFactory.Start
+	}
+
 	{
 		informerFactory.Start(ctx.Done())
+		startNativeInformers(ctx.Done())
 
 		informerStarted = true
 	}
 
-	return &sourceState{
-		lister:        pprInformer.Lister(),
-		hasSynced:     pprInformer.Informer().HasSynced,
-		selectorIndex: selectorIndex,
-		cancelFunc:    cancelFunc,
-	}, nil
+	return state, nil
+}
+
+func setupClusterPprSupport(
+	ctx context.Context,
+	cpprInformer podseidonv1a1informers.ClusterPodProtectorInformer,
+	namespaceInformer corev1informers.NamespaceInformer,
+	runPostHandlers func(types.NamespacedName),
+	clusterSelectorIndex ClusterSelectorIndex,
+	observeStartEnqueue o11y.ObserveScopeFunc[types.NamespacedName],
+	observeEndEnqueue func(context.Context),
+	observeEnqueueError func(context.Context, types.NamespacedName, error),
+) error {
+	if err := SetupClusterPprInformer(
+		ctx, cpprInformer,
+		runPostHandlers,
+		clusterSelectorIndex,
+		observeStartEnqueue, observeEndEnqueue, observeEnqueueError,
+	); err != nil {
+		return errors.TagWrapf("SetupClusterPprInformer", err, "create cluster ppr informer for cell")
+	}
+
+	// Namespace label changes may change the set of pods protected by any ClusterPodProtector.
+	requeueClusterPprs := func() {
+		cpprs, err := cpprInformer.Lister().List(labels.Everything())
+		if err != nil {
+			return
+		}
+
+		for _, cppr := range cpprs {
+			runPostHandlers(types.NamespacedName{Namespace: "", Name: cppr.Name})
+		}
+	}
+
+	if _, err := namespaceInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(any) { requeueClusterPprs() },
+		UpdateFunc: func(oldObj, newObj any) {
+			oldNs, oldOk := oldObj.(*corev1.Namespace)
+			newNs, newOk := newObj.(*corev1.Namespace)
+
+			if !oldOk || !newOk || !maps.Equal(oldNs.Labels, newNs.Labels) {
+				requeueClusterPprs()
+			}
+		},
+		DeleteFunc: func(any) { requeueClusterPprs() },
+	}); err != nil {
+		return errors.TagWrapf("AddNamespaceEventHandler", err, "add event handler to Namespace informer")
+	}
+
+	return nil
 }
 
 func (state *informerState) AddPostHandler(handler func(PodProtectorKey)) {
@@ -341,8 +468,10 @@ func (state *informerState) HasSynced() bool {
 	}
 
 	for _, cell := range *sources {
-		if !cell.hasSynced() {
-			return false
+		for _, hasSynced := range cell.hasSynced {
+			if !hasSynced() {
+				return false
+			}
 		}
 	}
 
@@ -350,8 +479,25 @@ func (state *informerState) HasSynced() bool {
 }
 
 func (state *informerState) Get(key PodProtectorKey) (optional.Optional[*podseidonv1a1.PodProtector], error) {
+	result, err := state.lookup(key)
+	if err != nil {
+		return optional.None[*podseidonv1a1.PodProtector](), err
+	}
+
+	return optional.Map(result, func(result lookupResult) *podseidonv1a1.PodProtector { return result.ppr }), nil
+}
+
+type lookupResult struct {
+	ppr    *podseidonv1a1.PodProtector
+	source *sourceState
+
+	// Only set for ClusterPodProtectors.
+	namespaceSelector *metav1.LabelSelector
+}
+
+func (state *informerState) lookup(key PodProtectorKey) (optional.Optional[lookupResult], error) {
 	// If there are multiple versions from different cells, select the version with the latest creationTimestamp
-	out := optional.None[*podseidonv1a1.PodProtector]()
+	out := optional.None[lookupResult]()
 
 	sources := ptr.Deref(state.sources.Load(), nil)
 
@@ -362,14 +508,41 @@ func (state *informerState) Get(key PodProtectorKey) (optional.Optional[*podseid
 	}
 
 	for _, cellState := range sources {
-		ppr, err := cellState.lister.PodProtectors(key.Namespace).Get(key.Name)
+		var result lookupResult
+
+		var err error
+
+		if key.IsClusterScoped() {
+			if cellState.clusterLister == nil {
+				continue // ClusterPodProtectors are not supported by this source
+			}
+
+			var cppr *podseidonv1a1.ClusterPodProtector
+
+			cppr, err = cellState.clusterLister.Get(key.Name)
+			if err == nil && cppr != nil {
+				result = lookupResult{
+					ppr:               ClusterPodProtectorView(cppr),
+					source:            cellState,
+					namespaceSelector: &cppr.Spec.NamespaceSelector,
+				}
+			}
+		} else {
+			var ppr *podseidonv1a1.PodProtector
+
+			ppr, err = cellState.lister.PodProtectors(key.Namespace).Get(key.Name)
+			if err == nil && ppr != nil {
+				result = lookupResult{ppr: ppr, source: cellState, namespaceSelector: nil}
+			}
+		}
+
 		if err != nil && !apierrors.IsNotFound(err) {
 			return out, errors.TagWrapf("GetListerPpr", err, "get ppr from lister")
 		}
 
-		if err == nil && ppr != nil {
-			out.SetOrChoose(ppr, func(pp1, pp2 *podseidonv1a1.PodProtector) bool {
-				return pp2.CreationTimestamp.Time.After(pp1.CreationTimestamp.Time)
+		if result.ppr != nil {
+			out.SetOrChoose(result, func(r1, r2 lookupResult) bool {
+				return r2.ppr.CreationTimestamp.Time.After(r1.ppr.CreationTimestamp.Time)
 			})
 		}
 	}
@@ -381,25 +554,146 @@ func (state *informerState) Query(namespace string, labels map[string]string) []
 	out := sets.New[PodProtectorKey]()
 
 	for sourceName, cellState := range ptr.Deref(state.sources.Load(), nil) {
+		keySourceName := SourceName("")
+		if state.isSourceIdent {
+			keySourceName = sourceName
+		}
+
 		nameIter, _ := cellState.selectorIndex.Query(labelindex.NamespacedQuery[map[string]string]{
 			Namespace: namespace,
 			Query:     labels,
 		})
 		nameIter(func(nn types.NamespacedName) iter.Flow {
-			key := PodProtectorKey{
-				SourceName:     "",
+			out.Insert(PodProtectorKey{
+				SourceName:     keySourceName,
 				NamespacedName: nn,
-			}
-
-			if state.isSourceIdent {
-				key.SourceName = sourceName
-			}
-
-			out.Insert(key)
+			})
 
 			return iter.Continue
 		})
+
+		// Collect the names first to avoid holding the index lock during lister lookups.
+		clusterNameIter, _ := cellState.clusterSelectorIndex.Query(labels)
+		for _, name := range clusterNameIter.CollectSlice() {
+			if cellState.clusterPprMatchesNamespace(name, namespace) {
+				out.Insert(PodProtectorKey{
+					SourceName:     keySourceName,
+					NamespacedName: types.NamespacedName{Namespace: "", Name: name},
+				})
+			}
+		}
 	}
 
 	return out.UnsortedList()
 }
+
+func (cellState *sourceState) clusterPprMatchesNamespace(name string, namespace string) bool {
+	cppr, err := cellState.clusterLister.Get(name)
+	if err != nil {
+		return false
+	}
+
+	ns, err := cellState.namespaceLister.Get(namespace)
+	if err != nil {
+		return false
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&cppr.Spec.NamespaceSelector)
+	if err != nil {
+		return false
+	}
+
+	return selector.Matches(labels.Set(ns.Labels))
+}
+
+func (state *informerState) MatchingNamespaces(key PodProtectorKey) ([]string, error) {
+	if !key.IsClusterScoped() {
+		return []string{key.Namespace}, nil
+	}
+
+	result, err := state.lookup(key)
+	if err != nil {
+		return nil, err
+	}
+
+	found, exists := result.Get()
+	if !exists {
+		return nil, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(found.namespaceSelector)
+	if err != nil {
+		return nil, errors.TagWrapf("ParseNamespaceSelector", err, "invalid namespace selector")
+	}
+
+	namespaces, err := found.source.namespaceLister.List(selector)
+	if err != nil {
+		return nil, errors.TagWrapf("ListNamespaces", err, "list namespaces from lister")
+	}
+
+	names := make([]string, 0, len(namespaces))
+	for _, ns := range namespaces {
+		names = append(names, ns.Name)
+	}
+
+	return names, nil
+}
+
+func (state *informerState) GetGroup(key GroupKey) (optional.Optional[*podseidonv1a1.PodProtectorGroup], error) {
+	result, err := state.lookupGroup(key)
+	if err != nil {
+		return optional.None[*podseidonv1a1.PodProtectorGroup](), err
+	}
+
+	return optional.Map(result, func(result groupLookupResult) *podseidonv1a1.PodProtectorGroup { return result.group }), nil
+}
+
+func (state *informerState) ListGroupMembers(key GroupKey) ([]*podseidonv1a1.PodProtector, error) {
+	result, err := state.lookupGroup(key)
+	if err != nil {
+		return nil, err
+	}
+
+	found, exists := result.Get()
+	if !exists {
+		return nil, nil
+	}
+
+	members, err := found.source.lister.PodProtectors(key.Namespace).List(
+		labels.SelectorFromSet(labels.Set{podseidon.PprGroupLabel: key.Name}),
+	)
+	if err != nil {
+		return nil, errors.TagWrapf("ListGroupMembers", err, "list group members from lister")
+	}
+
+	return members, nil
+}
+
+type groupLookupResult struct {
+	group  *podseidonv1a1.PodProtectorGroup
+	source *sourceState
+}
+
+func (state *informerState) lookupGroup(key GroupKey) (optional.Optional[groupLookupResult], error) {
+	// If there are multiple versions from different cells, select the version with the latest creationTimestamp
+	out := optional.None[groupLookupResult]()
+
+	for sourceName, cellState := range ptr.Deref(state.sources.Load(), nil) {
+		if state.isSourceIdent && sourceName != key.SourceName {
+			continue
+		}
+
+		group, err := cellState.groupLister.PodProtectorGroups(key.Namespace).Get(key.Name)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return out, errors.TagWrapf("GetListerGroup", err, "get group from lister")
+		}
+
+		if err == nil && group != nil {
+			out.SetOrChoose(groupLookupResult{group: group, source: cellState}, func(r1, r2 groupLookupResult) bool {
+				return r2.group.CreationTimestamp.Time.After(r1.group.CreationTimestamp.Time)
+			})
+		}
+	}
+
+	return out, nil
+}
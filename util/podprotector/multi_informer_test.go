@@ -0,0 +1,207 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pprutil_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
+
+	"github.com/kubewharf/podseidon/util/cmd"
+	"github.com/kubewharf/podseidon/util/component"
+	"github.com/kubewharf/podseidon/util/kube"
+	"github.com/kubewharf/podseidon/util/optional"
+	pprutil "github.com/kubewharf/podseidon/util/podprotector"
+)
+
+var (
+	podLabels     = map[string]string{"app": "foo"}
+	clusterPprKey = pprutil.PodProtectorKey{
+		SourceName:     "",
+		NamespacedName: types.NamespacedName{Namespace: "", Name: "cluster-ppr"},
+	}
+	namespacedPprKey = pprutil.PodProtectorKey{
+		SourceName:     "",
+		NamespacedName: types.NamespacedName{Namespace: "dev", Name: "ppr"},
+	}
+)
+
+func TestQueryClusterPodProtectorNamespaceSelector(t *testing.T) {
+	t.Parallel()
+
+	informer, _ := setupInformerTest(t, true)
+
+	assert.Contains(t, informer.Query("prod", podLabels), clusterPprKey)
+	assert.NotContains(t, informer.Query("dev", podLabels), clusterPprKey)
+	assert.NotContains(t, informer.Query("prod", map[string]string{"app": "bar"}), clusterPprKey)
+
+	namespaces, err := informer.MatchingNamespaces(clusterPprKey)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"prod"}, namespaces)
+}
+
+func TestQueryClusterPodProtectorNamespaceLabelChange(t *testing.T) {
+	t.Parallel()
+
+	informer, client := setupInformerTest(t, true)
+
+	ctx := context.Background()
+	namespaces := client.NativeClientSet().CoreV1().Namespaces()
+
+	dev, err := namespaces.Get(ctx, "dev", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	dev.Labels = map[string]string{"env": "prod"}
+	_, err = namespaces.Update(ctx, dev, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	prod, err := namespaces.Get(ctx, "prod", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	prod.Labels = map[string]string{"env": "staging"}
+	_, err = namespaces.Update(ctx, prod, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return slices.Contains(informer.Query("dev", podLabels), clusterPprKey)
+	}, time.Second*5, time.Millisecond*10)
+
+	require.Eventually(t, func() bool {
+		return len(informer.Query("prod", podLabels)) == 0
+	}, time.Second*5, time.Millisecond*10)
+
+	matching, err := informer.MatchingNamespaces(clusterPprKey)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dev"}, matching)
+}
+
+func TestQueryIgnoresClusterPodProtectorWithoutNativeClient(t *testing.T) {
+	t.Parallel()
+
+	informer, _ := setupInformerTest(t, false)
+
+	assert.Empty(t, informer.Query("prod", podLabels))
+	assert.Equal(t, []pprutil.PodProtectorKey{namespacedPprKey}, informer.Query("dev", podLabels))
+
+	cppr, err := informer.Get(clusterPprKey)
+	require.NoError(t, err)
+	assert.False(t, cppr.IsSome())
+}
+
+// Starts an indexed informer on a single source with the namespaces `prod` and `dev`,
+// a ClusterPodProtector selecting `prod`, and a PodProtector in `dev`.
+func setupInformerTest(t *testing.T, withNativeClient bool) (pprutil.IndexedInformer, *kube.Client) {
+	t.Helper()
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	client := kube.MockClient(
+		testNamespace("prod", "prod"),
+		testNamespace("dev", "dev"),
+		//nolint:exhaustruct
+		&podseidonv1a1.ClusterPodProtector{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: podseidonv1a1.SchemeGroupVersion.String(),
+				Kind:       "ClusterPodProtector",
+			},
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster-ppr"},
+			Spec: podseidonv1a1.ClusterPodProtectorSpec{
+				NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+				PodProtectorSpec: podseidonv1a1.PodProtectorSpec{
+					Selector: metav1.LabelSelector{MatchLabels: podLabels},
+				},
+			},
+		},
+		//nolint:exhaustruct
+		&podseidonv1a1.PodProtector{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: podseidonv1a1.SchemeGroupVersion.String(),
+				Kind:       podseidonv1a1.PodProtectorKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "dev", Name: "ppr"},
+			Spec: podseidonv1a1.PodProtectorSpec{
+				Selector: metav1.LabelSelector{MatchLabels: podLabels},
+			},
+		},
+	)
+
+	desc := pprutil.SourceDesc{
+		PodseidonClient: client.PodseidonClientSet(),
+		NativeClient:    nil,
+	}
+	if withNativeClient {
+		desc.NativeClient = client.NativeClientSet()
+	}
+
+	apiMap := cmd.MockStartup(ctx, []func(*component.DepRequests){
+		component.ApiOnly[pprutil.SourceProvider](pprutil.SourceProviderMuxName, testSourceProvider{desc: desc}),
+		component.RequireDep(pprutil.NewIndexedInformer(pprutil.IndexedInformerArgs{
+			Suffix:  "",
+			Elector: optional.None[kube.ElectorArgs](),
+		})),
+	})
+
+	informer := component.ApiFromMap[pprutil.IndexedInformer](apiMap, "podprotector-indexed-informer-")
+	require.Eventually(t, informer.HasSynced, time.Second*5, time.Millisecond*10)
+
+	return informer, client
+}
+
+func testNamespace(name string, env string) *corev1.Namespace {
+	//nolint:exhaustruct
+	return &corev1.Namespace{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"env": env},
+		},
+	}
+}
+
+// Provides a single source with a fixed SourceDesc.
+type testSourceProvider struct {
+	desc pprutil.SourceDesc
+}
+
+func (testSourceProvider) IsSourceIdentifying() bool { return false }
+
+func (provider testSourceProvider) Watch(context.Context) <-chan map[pprutil.SourceName]pprutil.SourceDesc {
+	ch := make(chan map[pprutil.SourceName]pprutil.SourceDesc, 1)
+	ch <- map[pprutil.SourceName]pprutil.SourceDesc{"": provider.desc}
+
+	return ch
+}
+
+func (testSourceProvider) UpdateStatus(context.Context, pprutil.SourceName, *podseidonv1a1.PodProtector) error {
+	return nil
+}
+
+func (testSourceProvider) UpdateGroupStatus(
+	context.Context,
+	pprutil.SourceName,
+	*podseidonv1a1.PodProtectorGroup,
+) error {
+	return nil
+}
@@ -17,13 +17,16 @@ package pprutil
 import (
 	"fmt"
 	"sort"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 
 	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
 
 	"github.com/kubewharf/podseidon/util/defaultconfig"
+	"github.com/kubewharf/podseidon/util/util"
 )
 
 func GetAggregationSelector(ppr *podseidonv1a1.PodProtector) metav1.LabelSelector {
@@ -37,28 +40,36 @@ func GetAggregationSelector(ppr *podseidonv1a1.PodProtector) metav1.LabelSelecto
 // The caller is responsible for cleaning up obsolete buckets.
 // This function assumes that all remaining buckets are lagging buckets.
 // Failure to do so would result in incorrect EstimatedAvailable computation.
-func Summarize(config defaultconfig.Computed, ppr *podseidonv1a1.PodProtector) {
+//
+// Stale cells, as determined by IsCellStale at `now`, are counted according to config.StaleCellPolicy.
+// Quota leases active at `now` are deducted from EstimatedAvailable.
+func Summarize(config defaultconfig.Computed, ppr *podseidonv1a1.PodProtector, now time.Time) {
 	summary := &ppr.Status.Summary
 	*summary = podseidonv1a1.PodProtectorStatusSummary{
+		MinAvailable:        0,
 		Total:               0,
 		AggregatedAvailable: 0,
 		MaxLatencyMillis:    0,
 		EstimatedAvailable:  0,
+		StaleCells:          0,
+		Leased:              0,
 		AggregatedReady:     0,
 		AggregatedScheduled: 0,
 		AggregatedRunning:   0,
 	}
 
+	outstanding := int32(0)
+
 	for cellId := range ppr.Status.Cells {
 		cell := &ppr.Status.Cells[cellId]
 
 		summary.Total += cell.Aggregation.TotalReplicas
-		summary.AggregatedAvailable += cell.Aggregation.AvailableReplicas
 		summary.AggregatedReady += cell.Aggregation.ReadyReplicas
 		summary.AggregatedScheduled += cell.Aggregation.ScheduledReplicas
 		summary.AggregatedRunning += cell.Aggregation.RunningReplicas
 
 		CompactBuckets(config, &cell.History.Buckets)
+		CompactBuckets(config, &cell.History.Observed)
 
 		if len(cell.History.Buckets) > 0 {
 			lagDuration := cell.History.Buckets[len(cell.History.Buckets)-1].StartTime.Sub(
@@ -69,15 +80,111 @@ func Summarize(config defaultconfig.Computed, ppr *podseidonv1a1.PodProtector) {
 				lagDuration.Milliseconds(),
 			)
 		}
+
+		cellAvailable := cell.Aggregation.AvailableReplicas
+		cellOutstanding := countBuckets(cell.History.Buckets)
+
+		if IsCellStale(cell, now, config.StaleCellThreshold) {
+			summary.StaleCells++
+
+			// The outstanding admissions of a stale cell may never be observed,
+			// so they are not counted as transitional quota.
+			if config.StaleCellPolicy == podseidonv1a1.StaleCellPolicyZero {
+				cellAvailable = 0
+			} else {
+				cellAvailable = max(cellAvailable-cellOutstanding, 0)
+			}
+
+			cellOutstanding = 0
+		}
+
+		summary.AggregatedAvailable += cellAvailable
+		outstanding += cellOutstanding
+	}
+
+	for _, lease := range ppr.Status.Leases {
+		if IsLeaseActive(lease, ppr.Status.Cells, now) {
+			summary.Leased += lease.Quota
+		}
 	}
 
-	summary.EstimatedAvailable = summary.AggregatedAvailable
+	summary.MinAvailable = ResolveMinAvailable(ppr.Spec, summary.Total)
+
+	summary.EstimatedAvailable = summary.AggregatedAvailable - outstanding - summary.Leased
+}
+
+// Whether the quota lease still reserves its quota.
+//
+// A lease keeps reserving its quota after expiryTime
+// until every aggregated cell has observed a reflector event no earlier than expiryTime,
+// since the deletions admitted against a lease that was never returned (e.g. because its holder terminated)
+// are not recorded in the admission history,
+// and are only accounted for once they are reflected in the aggregated available pods.
+// This is analogous to admission buckets, which are only cleared once aggregator has observed them.
+func IsLeaseActive(
+	lease podseidonv1a1.PodProtectorQuotaLease,
+	cells []podseidonv1a1.PodProtectorCellStatus,
+	now time.Time,
+) bool {
+	if now.Before(lease.ExpiryTime.Time) {
+		return true
+	}
 
-	for _, cell := range ppr.Status.Cells {
-		for _, bucket := range cell.History.Buckets {
-			summary.EstimatedAvailable -= ptr.Deref(bucket.Counter, 1)
+	for _, cell := range cells {
+		lastEventTime := cell.Aggregation.LastEventTime
+		if !lastEventTime.IsZero() && lastEventTime.Before(&lease.ExpiryTime) {
+			return true
 		}
 	}
+
+	return false
+}
+
+// Removes quota leases that no longer reserve quota, e.g. those left behind by a terminated webhook replica.
+func PruneExpiredLeases(ppr *podseidonv1a1.PodProtector, now time.Time) {
+	util.DrainSliceOrdered(&ppr.Status.Leases, func(lease podseidonv1a1.PodProtectorQuotaLease) bool {
+		return IsLeaseActive(lease, ppr.Status.Cells, now)
+	})
+}
+
+// Whether the cell has outstanding admission history
+// but its aggregator has not observed any reflector event for longer than the threshold,
+// e.g. because the aggregator is down.
+//
+// A zero threshold disables staleness detection.
+func IsCellStale(cell *podseidonv1a1.PodProtectorCellStatus, now time.Time, threshold time.Duration) bool {
+	return threshold > 0 &&
+		len(cell.History.Buckets) > 0 &&
+		now.Sub(cell.Aggregation.LastEventTime.Time) > threshold
+}
+
+func countBuckets(buckets []podseidonv1a1.PodProtectorAdmissionBucket) int32 {
+	count := int32(0)
+	for _, bucket := range buckets {
+		count += ptr.Deref(bucket.Counter, 1)
+	}
+
+	return count
+}
+
+// Resolves the effective minimum number of available pods against the total number of pods.
+func ResolveMinAvailable(spec podseidonv1a1.PodProtectorSpec, total int32) int32 {
+	return resolveMinAvailable(spec.MinAvailable, spec.MaxUnavailable, total)
+}
+
+// An invalid maxUnavailable is treated as zero, i.e. no pods may be unavailable,
+// since failing open would leave the pods unprotected.
+func resolveMinAvailable(minAvailable int32, maxUnavailableOpt *intstr.IntOrString, total int32) int32 {
+	if maxUnavailableOpt != nil {
+		maxUnavailable, err := intstr.GetScaledValueFromIntOrPercent(maxUnavailableOpt, int(total), true)
+		if err != nil {
+			maxUnavailable = 0
+		}
+
+		minAvailable = max(minAvailable, total-int32(min(max(maxUnavailable, 0), int(total))))
+	}
+
+	return minAvailable
 }
 
 func CompactBuckets(
@@ -98,6 +205,7 @@ func CompactBuckets(
 				// ptr.To is a misleading name; it actually allocates a new box and copies the value to the box.
 				EndTime: ptr.To(firstBucket.StartTime),
 			}
+			addUserCounter(&(*buckets)[0].Users, firstBucket.User, 1)
 		}
 
 		compactBucket := &(*buckets)[0]
@@ -105,6 +213,17 @@ func CompactBuckets(
 		for bucketId := 1; bucketId <= delta; bucketId++ {
 			bucket := (*buckets)[bucketId]
 
+			// Each compacted bucket still counts as outstanding unavailability in Summarize
+			// and as admissions in the disruption rate window,
+			// so its admissions must be carried over to the compact bucket.
+			*compactBucket.Counter += ptr.Deref(bucket.Counter, 1)
+
+			addUserCounter(&compactBucket.Users, bucket.User, 1)
+
+			for _, userCounter := range bucket.Users {
+				addUserCounter(&compactBucket.Users, userCounter.User, userCounter.Counter)
+			}
+
 			bucketEndTime := bucket.StartTime.Time
 			if bucket.EndTime != nil {
 				// There shouldn't be a compact bucket that isn't the oldest bucket,
@@ -123,6 +242,23 @@ func CompactBuckets(
 	}
 }
 
+// Adds the admissions of a user to the per-user counters of a compacted bucket.
+// Admissions without a recorded user are ignored.
+func addUserCounter(counters *[]podseidonv1a1.PodProtectorAdmissionUserCounter, user string, count int32) {
+	if user == "" {
+		return
+	}
+
+	counter := util.GetOrAppendSliceWith(
+		counters,
+		func(counter *podseidonv1a1.PodProtectorAdmissionUserCounter) bool { return counter.User == user },
+		func() podseidonv1a1.PodProtectorAdmissionUserCounter {
+			return podseidonv1a1.PodProtectorAdmissionUserCounter{User: user, Counter: 0}
+		},
+	)
+	counter.Counter += count
+}
+
 func ComputeDisruptionQuota(
 	minAvailable int32,
 	config defaultconfig.Computed,
@@ -162,6 +298,30 @@ func ComputeDisruptionQuota(
 	return quota
 }
 
+// Computes the disruption quota of a single cell under a cell constraint.
+//
+// The quota is computed in the same way as the global quota,
+// but only counting the aggregation and admission history of the cell.
+// MaxConcurrentLag is only enforced on the global quota.
+func ComputeCellDisruptionQuota(
+	constraint podseidonv1a1.PodProtectorCellConstraint,
+	cell *podseidonv1a1.PodProtectorCellStatus,
+) DisruptionQuota {
+	minAvailable := resolveMinAvailable(constraint.MinAvailable, constraint.MaxUnavailable, cell.Aggregation.TotalReplicas)
+
+	estimatedAvailable := cell.Aggregation.AvailableReplicas - countBuckets(cell.History.Buckets)
+
+	//nolint:exhaustruct // only the fields read by ComputeDisruptionQuota are relevant
+	return ComputeDisruptionQuota(
+		minAvailable,
+		defaultconfig.Computed{MaxConcurrentLag: 0},
+		podseidonv1a1.PodProtectorStatusSummary{
+			AggregatedAvailable: cell.Aggregation.AvailableReplicas,
+			EstimatedAvailable:  estimatedAvailable,
+		},
+	)
+}
+
 type DisruptionQuota struct {
 	// Number of replicas that can be disrupted without any risk.
 	Cleared int32
@@ -184,6 +344,32 @@ func (quota *DisruptionQuota) Disrupt() DisruptionResult {
 	return DisruptionResultDenied
 }
 
+// Disrupts one replica subject to all the given quotas.
+//
+// The result is the most restrictive result among the quotas.
+// Quotas are only consumed if the combined result allows it,
+// so that a replica denied by one quota does not consume the quota of another.
+func DisruptAll(quotas ...*DisruptionQuota) DisruptionResult {
+	result := DisruptionResultOk
+	for _, quota := range quotas {
+		result = max(result, quota.peek())
+	}
+
+	for _, quota := range quotas {
+		if quota.peek() == result {
+			quota.Disrupt()
+		}
+	}
+
+	return result
+}
+
+func (quota *DisruptionQuota) peek() DisruptionResult {
+	copied := *quota
+	return copied.Disrupt()
+}
+
+// Ordered by increasing restrictiveness.
 type DisruptionResult uint8
 
 const (
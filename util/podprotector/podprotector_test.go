@@ -0,0 +1,656 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pprutil_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
+
+	"github.com/kubewharf/podseidon/util/defaultconfig"
+	"github.com/kubewharf/podseidon/util/optional"
+	pprutil "github.com/kubewharf/podseidon/util/podprotector"
+)
+
+func TestResolveMinAvailable(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name           string
+		minAvailable   int32
+		maxUnavailable *intstr.IntOrString
+		total          int32
+		expect         int32
+	}{
+		{name: "min only", minAvailable: 3, maxUnavailable: nil, total: 10, expect: 3},
+		{name: "absolute max", minAvailable: 0, maxUnavailable: ptr.To(intstr.FromInt32(2)), total: 10, expect: 8},
+		{name: "percentage rounds up", minAvailable: 0, maxUnavailable: ptr.To(intstr.FromString("25%")), total: 10, expect: 7},
+		{name: "stricter min wins", minAvailable: 9, maxUnavailable: ptr.To(intstr.FromString("50%")), total: 10, expect: 9},
+		{name: "stricter max wins", minAvailable: 2, maxUnavailable: ptr.To(intstr.FromInt32(1)), total: 10, expect: 9},
+		{name: "max exceeds total", minAvailable: 0, maxUnavailable: ptr.To(intstr.FromInt32(20)), total: 10, expect: 0},
+		{name: "invalid max protects all", minAvailable: 0, maxUnavailable: ptr.To(intstr.FromString("x")), total: 10, expect: 10},
+		{name: "no pods", minAvailable: 0, maxUnavailable: ptr.To(intstr.FromString("10%")), total: 0, expect: 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			//nolint:exhaustruct
+			spec := podseidonv1a1.PodProtectorSpec{MinAvailable: tc.minAvailable, MaxUnavailable: tc.maxUnavailable}
+			assert.Equal(t, tc.expect, pprutil.ResolveMinAvailable(spec, tc.total))
+		})
+	}
+}
+
+func TestComputeCellDisruptionQuota(t *testing.T) {
+	t.Parallel()
+
+	//nolint:exhaustruct
+	cell := &podseidonv1a1.PodProtectorCellStatus{
+		CellId: "a",
+		Aggregation: podseidonv1a1.PodProtectorAggregation{
+			TotalReplicas:     10,
+			AvailableReplicas: 9,
+		},
+		History: podseidonv1a1.PodProtectorAdmissionHistory{
+			Buckets: []podseidonv1a1.PodProtectorAdmissionBucket{{}, {Counter: ptr.To[int32](2)}},
+		},
+	}
+
+	//nolint:exhaustruct
+	quota := pprutil.ComputeCellDisruptionQuota(podseidonv1a1.PodProtectorCellConstraint{MinAvailable: 4}, cell)
+	assert.Equal(t, pprutil.DisruptionQuota{Cleared: 6 - 4, Transitional: 3}, quota)
+
+	//nolint:exhaustruct
+	quota = pprutil.ComputeCellDisruptionQuota(podseidonv1a1.PodProtectorCellConstraint{
+		MaxUnavailable: ptr.To(intstr.FromString("20%")),
+	}, cell)
+	assert.Equal(t, pprutil.DisruptionQuota{Cleared: 0, Transitional: 9 - 8}, quota)
+}
+
+func TestCompactBucketsPreservesQuota(t *testing.T) {
+	t.Parallel()
+
+	baseTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	buckets := []podseidonv1a1.PodProtectorAdmissionBucket{}
+	for i := range 5 {
+		//nolint:exhaustruct
+		buckets = append(buckets, podseidonv1a1.PodProtectorAdmissionBucket{
+			StartTime: metav1.MicroTime{Time: baseTime.Add(time.Duration(i) * time.Second)},
+			PodUid:    ptr.To(types.UID(fmt.Sprint(i))),
+		})
+	}
+
+	//nolint:exhaustruct
+	ppr := &podseidonv1a1.PodProtector{
+		Spec: podseidonv1a1.PodProtectorSpec{MinAvailable: 3},
+		Status: podseidonv1a1.PodProtectorStatus{
+			Cells: []podseidonv1a1.PodProtectorCellStatus{
+				{
+					CellId: "cell",
+					Aggregation: podseidonv1a1.PodProtectorAggregation{
+						TotalReplicas:     10,
+						AvailableReplicas: 10,
+					},
+					History: podseidonv1a1.PodProtectorAdmissionHistory{Buckets: buckets},
+				},
+			},
+		},
+	}
+
+	//nolint:exhaustruct
+	pprutil.Summarize(defaultconfig.Computed{CompactThreshold: 2}, ppr, baseTime)
+
+	assert.Len(t, ppr.Status.Cells[0].History.Buckets, 2, "history is compacted")
+	assert.Equal(t, int32(5), ppr.Status.Summary.EstimatedAvailable, "compaction must not release quota of admitted deletions")
+}
+
+func TestCompactBucketsUserCounters(t *testing.T) {
+	t.Parallel()
+
+	baseTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	bucket := func(offset time.Duration, user string) podseidonv1a1.PodProtectorAdmissionBucket {
+		//nolint:exhaustruct
+		return podseidonv1a1.PodProtectorAdmissionBucket{
+			StartTime: metav1.MicroTime{Time: baseTime.Add(offset)},
+			PodUid:    ptr.To(types.UID(offset.String())),
+			User:      user,
+			Operation: podseidonv1a1.PodProtectorAdmissionOperationDelete,
+		}
+	}
+
+	buckets := []podseidonv1a1.PodProtectorAdmissionBucket{
+		bucket(time.Second, "alice"),
+		bucket(2*time.Second, "bob"),
+		bucket(3*time.Second, "alice"),
+		bucket(4*time.Second, ""),
+		bucket(5*time.Second, "bob"),
+	}
+
+	//nolint:exhaustruct
+	pprutil.CompactBuckets(defaultconfig.Computed{CompactThreshold: 2}, &buckets)
+
+	assert.Len(t, buckets, 2)
+	assert.Equal(t, int32(4), ptr.Deref(buckets[0].Counter, 0))
+	assert.Equal(t, baseTime.Add(4*time.Second), buckets[0].EndTime.Time)
+	assert.Equal(t, []podseidonv1a1.PodProtectorAdmissionUserCounter{
+		{User: "alice", Counter: 2},
+		{User: "bob", Counter: 1},
+	}, buckets[0].Users)
+	assert.Equal(t, "bob", buckets[1].User)
+
+	buckets = append(buckets, bucket(6*time.Second, "alice"))
+
+	//nolint:exhaustruct
+	pprutil.CompactBuckets(defaultconfig.Computed{CompactThreshold: 2}, &buckets)
+
+	assert.Len(t, buckets, 2)
+	assert.Equal(t, int32(5), ptr.Deref(buckets[0].Counter, 0))
+	assert.Equal(t, []podseidonv1a1.PodProtectorAdmissionUserCounter{
+		{User: "alice", Counter: 2},
+		{User: "bob", Counter: 2},
+	}, buckets[0].Users)
+}
+
+func TestSummarizeStaleCells(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+
+	cell := func(cellId string, lastEventAge time.Duration, buckets int) podseidonv1a1.PodProtectorCellStatus {
+		//nolint:exhaustruct
+		status := podseidonv1a1.PodProtectorCellStatus{
+			CellId: cellId,
+			Aggregation: podseidonv1a1.PodProtectorAggregation{
+				TotalReplicas:     5,
+				AvailableReplicas: 5,
+				LastEventTime:     metav1.MicroTime{Time: now.Add(-lastEventAge)},
+			},
+		}
+
+		for i := range buckets {
+			status.History.Buckets = append(status.History.Buckets, podseidonv1a1.PodProtectorAdmissionBucket{
+				StartTime: metav1.MicroTime{Time: now.Add(-time.Duration(i) * time.Second)},
+				PodUid:    ptr.To(types.UID(fmt.Sprintf("%s-%d", cellId, i))),
+			})
+		}
+
+		return status
+	}
+
+	for _, tc := range []struct {
+		name             string
+		threshold        time.Duration
+		policy           podseidonv1a1.StaleCellPolicy
+		expectAggregated int32
+		expectEstimated  int32
+		expectStaleCells int32
+	}{
+		{
+			name:             "disabled",
+			threshold:        0,
+			policy:           podseidonv1a1.StaleCellPolicyZero,
+			expectAggregated: 15,
+			expectEstimated:  12,
+			expectStaleCells: 0,
+		},
+		{
+			name:             "zero",
+			threshold:        time.Minute,
+			policy:           podseidonv1a1.StaleCellPolicyZero,
+			expectAggregated: 10,
+			expectEstimated:  9,
+			expectStaleCells: 1,
+		},
+		{
+			name:             "estimated",
+			threshold:        time.Minute,
+			policy:           podseidonv1a1.StaleCellPolicyEstimated,
+			expectAggregated: 13,
+			expectEstimated:  12,
+			expectStaleCells: 1,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			//nolint:exhaustruct
+			ppr := &podseidonv1a1.PodProtector{
+				Status: podseidonv1a1.PodProtectorStatus{
+					Cells: []podseidonv1a1.PodProtectorCellStatus{
+						cell("fresh", time.Second, 1),
+						cell("stale", time.Hour, 2),
+						// Cells without outstanding admissions are never stale.
+						cell("idle", time.Hour, 0),
+					},
+				},
+			}
+
+			//nolint:exhaustruct
+			pprutil.Summarize(defaultconfig.Computed{
+				CompactThreshold:   100,
+				StaleCellThreshold: tc.threshold,
+				StaleCellPolicy:    tc.policy,
+			}, ppr, now)
+
+			assert.Equal(t, int32(15), ppr.Status.Summary.Total)
+			assert.Equal(t, tc.expectAggregated, ppr.Status.Summary.AggregatedAvailable)
+			assert.Equal(t, tc.expectEstimated, ppr.Status.Summary.EstimatedAvailable)
+			assert.Equal(t, tc.expectStaleCells, ppr.Status.Summary.StaleCells)
+		})
+	}
+}
+
+// The Estimated policy does not reduce EstimatedAvailable, and therefore not the cleared quota;
+// it only denies the deletions that would otherwise be retried until the stale cell observes its outstanding admissions.
+func TestStaleCellPolicyEstimatedQuota(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+
+	//nolint:exhaustruct
+	ppr := &podseidonv1a1.PodProtector{
+		Spec: podseidonv1a1.PodProtectorSpec{MinAvailable: 5},
+		Status: podseidonv1a1.PodProtectorStatus{
+			Cells: []podseidonv1a1.PodProtectorCellStatus{
+				{
+					CellId: "stale",
+					Aggregation: podseidonv1a1.PodProtectorAggregation{
+						TotalReplicas:     10,
+						AvailableReplicas: 10,
+						LastEventTime:     metav1.MicroTime{Time: now.Add(-time.Hour)},
+					},
+					History: podseidonv1a1.PodProtectorAdmissionHistory{
+						Buckets: []podseidonv1a1.PodProtectorAdmissionBucket{
+							{StartTime: metav1.MicroTime{Time: now}, PodUid: ptr.To(types.UID("a"))},
+							{StartTime: metav1.MicroTime{Time: now}, PodUid: ptr.To(types.UID("b"))},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	//nolint:exhaustruct
+	freshConfig := defaultconfig.Computed{CompactThreshold: 100}
+	pprutil.Summarize(freshConfig, ppr, now)
+	freshSummary := ppr.Status.Summary
+
+	//nolint:exhaustruct
+	staleConfig := defaultconfig.Computed{
+		CompactThreshold:   100,
+		StaleCellThreshold: time.Minute,
+		StaleCellPolicy:    podseidonv1a1.StaleCellPolicyEstimated,
+	}
+	pprutil.Summarize(staleConfig, ppr, now)
+	staleSummary := ppr.Status.Summary
+
+	assert.Equal(t, int32(8), freshSummary.EstimatedAvailable)
+	assert.Equal(t, freshSummary.EstimatedAvailable, staleSummary.EstimatedAvailable)
+	assert.Equal(t, int32(8), staleSummary.AggregatedAvailable)
+
+	assert.Equal(
+		t,
+		pprutil.DisruptionQuota{Cleared: 3, Transitional: 2},
+		pprutil.ComputeDisruptionQuota(freshSummary.MinAvailable, freshConfig, freshSummary),
+	)
+	assert.Equal(
+		t,
+		pprutil.DisruptionQuota{Cleared: 3, Transitional: 0},
+		pprutil.ComputeDisruptionQuota(staleSummary.MinAvailable, staleConfig, staleSummary),
+		"outstanding admissions of the stale cell are denied instead of retried",
+	)
+}
+
+func TestSummarizeLeases(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+
+	//nolint:exhaustruct
+	ppr := &podseidonv1a1.PodProtector{
+		Status: podseidonv1a1.PodProtectorStatus{
+			Cells: []podseidonv1a1.PodProtectorCellStatus{
+				{
+					CellId: "cell",
+					Aggregation: podseidonv1a1.PodProtectorAggregation{
+						TotalReplicas:     10,
+						AvailableReplicas: 10,
+						LastEventTime:     metav1.MicroTime{Time: now.Add(-time.Second * 2)},
+					},
+				},
+			},
+			Leases: []podseidonv1a1.PodProtectorQuotaLease{
+				{Id: "active", Quota: 3, ExpiryTime: metav1.MicroTime{Time: now.Add(time.Second)}},
+				{Id: "unobserved", Quota: 2, ExpiryTime: metav1.MicroTime{Time: now.Add(-time.Second)}},
+				{Id: "observed", Quota: 4, ExpiryTime: metav1.MicroTime{Time: now.Add(-time.Second * 3)}},
+			},
+		},
+	}
+
+	//nolint:exhaustruct
+	config := defaultconfig.Computed{CompactThreshold: 100}
+	pprutil.Summarize(config, ppr, now)
+
+	assert.Equal(t, int32(5), ppr.Status.Summary.Leased, "expired leases count until aggregation catches up")
+	assert.Equal(t, int32(10), ppr.Status.Summary.AggregatedAvailable)
+	assert.Equal(t, int32(5), ppr.Status.Summary.EstimatedAvailable)
+
+	pprutil.PruneExpiredLeases(ppr, now)
+	assert.Equal(t, []string{"active", "unobserved"}, leaseIds(ppr.Status.Leases))
+
+	ppr.Status.Cells[0].Aggregation.LastEventTime = metav1.MicroTime{Time: now}
+	pprutil.Summarize(config, ppr, now)
+	assert.Equal(t, int32(3), ppr.Status.Summary.Leased)
+
+	pprutil.PruneExpiredLeases(ppr, now)
+	assert.Equal(t, []string{"active"}, leaseIds(ppr.Status.Leases))
+}
+
+func leaseIds(leases []podseidonv1a1.PodProtectorQuotaLease) []string {
+	ids := make([]string, 0, len(leases))
+	for _, lease := range leases {
+		ids = append(ids, lease.Id)
+	}
+
+	return ids
+}
+
+func TestDisruptAll(t *testing.T) {
+	t.Parallel()
+
+	global := pprutil.DisruptionQuota{Cleared: 2, Transitional: 0}
+	cell := pprutil.DisruptionQuota{Cleared: 1, Transitional: 1}
+
+	assert.Equal(t, pprutil.DisruptionResultOk, pprutil.DisruptAll(&global, &cell))
+	assert.Equal(t, pprutil.DisruptionResultRetry, pprutil.DisruptAll(&global, &cell))
+	assert.Equal(t, pprutil.DisruptionQuota{Cleared: 1, Transitional: 0}, global, "global quota is not consumed by retry")
+	assert.Equal(t, pprutil.DisruptionResultDenied, pprutil.DisruptAll(&global, &cell))
+	assert.Equal(t, pprutil.DisruptionQuota{Cleared: 1, Transitional: 0}, global, "global quota is not consumed by denial")
+}
+
+func TestUpdateConditions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	config := defaultconfig.Computed{StaleCellThreshold: time.Minute}
+	thresholds := pprutil.ConditionThresholds{HistoryLag: time.Second * 10}
+
+	//nolint:exhaustruct
+	ppr := &podseidonv1a1.PodProtector{
+		ObjectMeta: metav1.ObjectMeta{Generation: 2},
+		Status: podseidonv1a1.PodProtectorStatus{
+			Cells: []podseidonv1a1.PodProtectorCellStatus{
+				{
+					CellId: "fresh",
+					Aggregation: podseidonv1a1.PodProtectorAggregation{
+						LastEventTime: metav1.MicroTime{Time: now.Add(-time.Second)},
+					},
+				},
+				{
+					CellId: "stale",
+					Aggregation: podseidonv1a1.PodProtectorAggregation{
+						LastEventTime: metav1.MicroTime{Time: now.Add(-time.Hour)},
+					},
+					History: podseidonv1a1.PodProtectorAdmissionHistory{
+						Buckets: []podseidonv1a1.PodProtectorAdmissionBucket{{}},
+					},
+				},
+			},
+			Summary: podseidonv1a1.PodProtectorStatusSummary{
+				MinAvailable:        3,
+				AggregatedAvailable: 3,
+				MaxLatencyMillis:    1000,
+			},
+		},
+	}
+
+	changed, recheckAfter := pprutil.UpdateConditions(config, ppr, now, thresholds)
+	assert.True(t, changed)
+	assert.Equal(t, optional.None[time.Duration](), recheckAfter, "fresh cell without outstanding history never becomes stale")
+	assert.Equal(t, int64(2), ppr.Status.ObservedGeneration)
+
+	status := func(conditionType string) metav1.ConditionStatus {
+		return meta.FindStatusCondition(ppr.Status.Conditions, conditionType).Status
+	}
+
+	assert.Equal(t, metav1.ConditionTrue, status(podseidonv1a1.ConditionTypeAggregated))
+	assert.Equal(t, metav1.ConditionTrue, status(podseidonv1a1.ConditionTypeAtRisk))
+	assert.Equal(t, metav1.ConditionTrue, status(podseidonv1a1.ConditionTypeStaleCell))
+	assert.Equal(t, metav1.ConditionFalse, status(podseidonv1a1.ConditionTypeHistoryLagging))
+	assert.Equal(t, metav1.ConditionFalse, status(podseidonv1a1.ConditionTypeInvalidWindow))
+
+	changed, _ = pprutil.UpdateConditions(config, ppr, now.Add(time.Second), thresholds)
+	assert.False(t, changed, "unchanged status is idempotent")
+
+	// The fresh cell receives an admission and becomes stale after the threshold without further events.
+	ppr.Status.Cells[0].History.Buckets = []podseidonv1a1.PodProtectorAdmissionBucket{{}}
+	ppr.Status.Cells[1].History.Buckets = nil
+
+	changed, recheckAfter = pprutil.UpdateConditions(config, ppr, now, thresholds)
+	assert.True(t, changed)
+	assert.Equal(t, metav1.ConditionFalse, status(podseidonv1a1.ConditionTypeStaleCell))
+	assert.Equal(t, optional.Some(time.Minute-time.Second+time.Nanosecond), recheckAfter)
+
+	changed, _ = pprutil.UpdateConditions(config, ppr, now.Add(recheckAfter.MustGet("checked above")), thresholds)
+	assert.True(t, changed)
+	assert.Equal(t, metav1.ConditionTrue, status(podseidonv1a1.ConditionTypeStaleCell))
+
+	ppr.Spec.Windows = []podseidonv1a1.PodProtectorWindow{
+		{Name: "typo", Schedule: "0 25 * * *", Duration: &metav1.Duration{Duration: time.Hour}},
+	}
+
+	changed, _ = pprutil.UpdateConditions(config, ppr, now, thresholds)
+	assert.True(t, changed)
+	assert.Equal(t, metav1.ConditionTrue, status(podseidonv1a1.ConditionTypeInvalidWindow))
+	assert.Contains(t, meta.FindStatusCondition(ppr.Status.Conditions, podseidonv1a1.ConditionTypeInvalidWindow).Message, "typo")
+}
+
+func TestDisruptionRate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	bucketAt := func(ago time.Duration) podseidonv1a1.PodProtectorAdmissionBucket {
+		//nolint:exhaustruct
+		return podseidonv1a1.PodProtectorAdmissionBucket{StartTime: metav1.MicroTime{Time: now.Add(-ago)}}
+	}
+
+	//nolint:exhaustruct
+	ppr := &podseidonv1a1.PodProtector{
+		Spec: podseidonv1a1.PodProtectorSpec{
+			DisruptionRate: &podseidonv1a1.PodProtectorDisruptionRate{
+				MaxDisruptions: 3,
+				Window:         metav1.Duration{Duration: time.Minute},
+			},
+		},
+		Status: podseidonv1a1.PodProtectorStatus{
+			Cells: []podseidonv1a1.PodProtectorCellStatus{
+				{
+					CellId: "a",
+					History: podseidonv1a1.PodProtectorAdmissionHistory{
+						Buckets:  []podseidonv1a1.PodProtectorAdmissionBucket{bucketAt(time.Second)},
+						Observed: []podseidonv1a1.PodProtectorAdmissionBucket{bucketAt(time.Second * 50), bucketAt(time.Hour)},
+					},
+				},
+				{
+					CellId: "b",
+					History: podseidonv1a1.PodProtectorAdmissionHistory{
+						Observed: []podseidonv1a1.PodProtectorAdmissionBucket{bucketAt(time.Second * 30)},
+					},
+				},
+			},
+		},
+	}
+
+	quota := pprutil.ComputeDisruptionRateQuota(ppr, now).MustGet("disruption rate is set")
+	assert.Equal(t, pprutil.DisruptionResultRetry, quota.Disrupt())
+	assert.Equal(t, optional.Some(time.Second*10), pprutil.DisruptionRateRetryAfter(ppr, now))
+
+	quota = pprutil.ComputeDisruptionRateQuota(ppr, now.Add(time.Second*15)).MustGet("disruption rate is set")
+	assert.Equal(t, pprutil.DisruptionResultOk, quota.Disrupt())
+	assert.Equal(t, pprutil.DisruptionResultRetry, quota.Disrupt())
+	assert.True(t, pprutil.DisruptionRateRetryAfter(ppr, now.Add(time.Second*15)).IsNone())
+
+	ppr.Spec.DisruptionRate = nil
+	assert.True(t, pprutil.ComputeDisruptionRateQuota(ppr, now).IsNone())
+}
+
+func TestActiveWindow(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.January, 5, 22, 30, 0, 0, time.UTC) // Monday
+
+	//nolint:exhaustruct
+	spec := podseidonv1a1.PodProtectorSpec{
+		MinAvailable: 8,
+		Windows: []podseidonv1a1.PodProtectorWindow{
+			{Name: "invalid", Schedule: "* * * *", Duration: &metav1.Duration{Duration: time.Hour}},
+			{
+				Name:   "past-release",
+				Start:  &metav1.Time{Time: now.Add(-time.Hour * 2)},
+				End:    &metav1.Time{Time: now.Add(-time.Hour)},
+				Action: podseidonv1a1.PodProtectorWindowActionFreeze,
+			},
+			{
+				Name:           "nightly-maintenance",
+				Schedule:       "0 22 * * 1-5",
+				Duration:       &metav1.Duration{Duration: time.Hour},
+				Action:         podseidonv1a1.PodProtectorWindowActionOverride,
+				MaxUnavailable: ptr.To(intstr.FromString("50%")),
+			},
+		},
+	}
+
+	window := pprutil.ActiveWindow(spec, now)
+	assert.Equal(t, "nightly-maintenance", window.MustGet("window is active").Name)
+	assert.True(t, pprutil.ActiveWindow(spec, now.Add(time.Hour)).IsNone())
+	assert.Equal(t, "past-release", pprutil.ActiveWindow(spec, now.Add(-time.Hour*2)).MustGet("window is active").Name)
+
+	//nolint:exhaustruct
+	ppr := &podseidonv1a1.PodProtector{
+		Spec:   spec,
+		Status: podseidonv1a1.PodProtectorStatus{Summary: podseidonv1a1.PodProtectorStatusSummary{MinAvailable: 8, Total: 10}},
+	}
+	assert.Equal(t, int32(8), pprutil.EffectiveMinAvailable(ppr, optional.None[*podseidonv1a1.PodProtectorWindow]()))
+	assert.Equal(t, int32(5), pprutil.EffectiveMinAvailable(ppr, window))
+}
+
+func TestClusterPodProtectorView(t *testing.T) {
+	t.Parallel()
+
+	//nolint:exhaustruct
+	cppr := &podseidonv1a1.ClusterPodProtector{
+		ObjectMeta: metav1.ObjectMeta{Name: "daemon", Generation: 3},
+		Spec: podseidonv1a1.ClusterPodProtectorSpec{
+			NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"tenant": "true"}},
+			PodProtectorSpec:  podseidonv1a1.PodProtectorSpec{MinAvailable: 5},
+		},
+	}
+
+	view := pprutil.ClusterPodProtectorView(cppr)
+	assert.Empty(t, view.Namespace)
+	assert.Equal(t, "daemon", view.Name)
+	assert.Equal(t, int32(5), view.Spec.MinAvailable)
+
+	//nolint:exhaustruct
+	clusterKey := pprutil.PodProtectorKey{NamespacedName: types.NamespacedName{Name: "daemon"}}
+	assert.True(t, clusterKey.IsClusterScoped())
+	assert.Equal(t, "ClusterPodProtector daemon", clusterKey.DisplayName())
+
+	//nolint:exhaustruct
+	namespacedKey := pprutil.PodProtectorKey{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "app"}}
+	assert.False(t, namespacedKey.IsClusterScoped())
+	assert.Equal(t, "PodProtector ns/app", namespacedKey.DisplayName())
+}
+
+func TestGroupDisruptionQuota(t *testing.T) {
+	t.Parallel()
+
+	eventTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	member := func(name string, total, available int32) *podseidonv1a1.PodProtector {
+		//nolint:exhaustruct
+		return &podseidonv1a1.PodProtector{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: name},
+			Status: podseidonv1a1.PodProtectorStatus{
+				Summary: podseidonv1a1.PodProtectorStatusSummary{Total: total, AggregatedAvailable: available},
+				Cells: []podseidonv1a1.PodProtectorCellStatus{{
+					CellId:      "cell",
+					Aggregation: podseidonv1a1.PodProtectorAggregation{LastEventTime: metav1.MicroTime{Time: eventTime}},
+				}},
+			},
+		}
+	}
+	members := []*podseidonv1a1.PodProtector{member("blue", 4, 4), member("green", 6, 5)}
+
+	admission := func(ppr string, offset time.Duration) podseidonv1a1.PodProtectorGroupAdmission {
+		//nolint:exhaustruct
+		return podseidonv1a1.PodProtectorGroupAdmission{
+			PodProtector: ppr,
+			CellId:       "cell",
+			StartTime:    metav1.MicroTime{Time: eventTime.Add(offset)},
+		}
+	}
+
+	//nolint:exhaustruct
+	group := &podseidonv1a1.PodProtectorGroup{
+		Spec: podseidonv1a1.PodProtectorGroupSpec{MaxUnavailable: ptr.To(intstr.FromString("20%"))},
+		Status: podseidonv1a1.PodProtectorGroupStatus{
+			Admissions: []podseidonv1a1.PodProtectorGroupAdmission{
+				admission("blue", -time.Second), // observed by aggregator
+				admission("green", time.Second),
+				admission("deleted", time.Second), // member no longer exists
+			},
+		},
+	}
+
+	assert.True(t, pprutil.PruneGroupAdmissions(group, members))
+	assert.Len(t, group.Status.Admissions, 1)
+	assert.Equal(t, "green", group.Status.Admissions[0].PodProtector)
+	assert.False(t, pprutil.PruneGroupAdmissions(group, members))
+
+	// total = 10, minAvailable = 8, aggregated = 9, estimated = 8
+	assert.Equal(
+		t,
+		pprutil.DisruptionQuota{Cleared: 0, Transitional: 1},
+		pprutil.ComputeGroupDisruptionQuota(group, members),
+	)
+
+	//nolint:exhaustruct
+	labeled := &podseidonv1a1.PodProtector{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"podseidon.kubewharf.io/group": "svc"}},
+	}
+
+	//nolint:exhaustruct
+	namespacedKey := pprutil.PodProtectorKey{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "blue"}}
+	groupKey, isMember := pprutil.GroupOf(namespacedKey, labeled).Get()
+	assert.True(t, isMember)
+	assert.Equal(t, types.NamespacedName{Namespace: "ns", Name: "svc"}, groupKey.NamespacedName)
+	assert.Equal(t, "PodProtectorGroup ns/svc", groupKey.DisplayName())
+
+	//nolint:exhaustruct
+	clusterKey := pprutil.PodProtectorKey{NamespacedName: types.NamespacedName{Name: "blue"}}
+	assert.True(t, pprutil.GroupOf(clusterKey, labeled).IsNone())
+}
@@ -84,6 +84,7 @@ func (provider singleSourceProvider) Watch(_ context.Context) <-chan map[SourceN
 	ch <- map[SourceName]SourceDesc{
 		"": {
 			PodseidonClient: provider.client.PodseidonClientSet(),
+			NativeClient:    provider.client.NativeClientSet(),
 		},
 	}
 
@@ -91,6 +92,18 @@ func (provider singleSourceProvider) Watch(_ context.Context) <-chan map[SourceN
 }
 
 func (provider singleSourceProvider) UpdateStatus(ctx context.Context, _ SourceName, ppr *podseidonv1a1.PodProtector) error {
+	if ppr.Namespace == "" {
+		_, err := provider.client.PodseidonClientSet().
+			PodseidonV1alpha1().
+			ClusterPodProtectors().
+			UpdateStatus(ctx, clusterPodProtectorFromView(ppr), metav1.UpdateOptions{})
+		if err != nil {
+			return errors.TagWrapf("ClientUpdateClusterStatus", err, "call update status on client")
+		}
+
+		return nil
+	}
+
 	_, err := provider.client.PodseidonClientSet().
 		PodseidonV1alpha1().
 		PodProtectors(ppr.Namespace).
@@ -101,3 +114,19 @@ func (provider singleSourceProvider) UpdateStatus(ctx context.Context, _ SourceN
 
 	return nil
 }
+
+func (provider singleSourceProvider) UpdateGroupStatus(
+	ctx context.Context,
+	_ SourceName,
+	group *podseidonv1a1.PodProtectorGroup,
+) error {
+	_, err := provider.client.PodseidonClientSet().
+		PodseidonV1alpha1().
+		PodProtectorGroups(group.Namespace).
+		UpdateStatus(ctx, group, metav1.UpdateOptions{})
+	if err != nil {
+		return errors.TagWrapf("ClientUpdateGroupStatus", err, "call update status on client")
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pprutil
+
+import (
+	"time"
+
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
+
+	"github.com/kubewharf/podseidon/util/cron"
+	"github.com/kubewharf/podseidon/util/errors"
+	"github.com/kubewharf/podseidon/util/optional"
+)
+
+// Returns the first window of the PodProtector active at the given time.
+// Invalid windows are never active.
+func ActiveWindow(spec podseidonv1a1.PodProtectorSpec, now time.Time) optional.Optional[*podseidonv1a1.PodProtectorWindow] {
+	for i := range spec.Windows {
+		window := &spec.Windows[i]
+
+		if active, err := IsWindowActive(window, now); err == nil && active {
+			return optional.Some(window)
+		}
+	}
+
+	return optional.None[*podseidonv1a1.PodProtectorWindow]()
+}
+
+// Whether the window is active at the given time.
+// Returns an error if the window is invalid.
+func IsWindowActive(window *podseidonv1a1.PodProtectorWindow, now time.Time) (bool, error) {
+	isOneOff := window.Start != nil || window.End != nil
+	isRecurring := window.Schedule != "" || window.Duration != nil
+
+	switch {
+	case isOneOff && isRecurring:
+		return false, errors.TagErrorf("AmbiguousWindow", "start/end and schedule/duration are mutually exclusive")
+	case isOneOff:
+		if window.Start == nil || window.End == nil {
+			return false, errors.TagErrorf("IncompleteWindow", "start and end must be specified together")
+		}
+
+		return !now.Before(window.Start.Time) && now.Before(window.End.Time), nil
+	case isRecurring:
+		if window.Schedule == "" || window.Duration == nil {
+			return false, errors.TagErrorf("IncompleteWindow", "schedule and duration must be specified together")
+		}
+
+		schedule, err := cron.Parse(window.Schedule)
+		if err != nil {
+			return false, errors.TagWrapf("ParseSchedule", err, "invalid schedule")
+		}
+
+		return schedule.FiresBetween(now.Add(-window.Duration.Duration), now), nil
+	default:
+		return false, errors.TagErrorf("EmptyWindow", "either start/end or schedule/duration must be specified")
+	}
+}
+
+// Returns the minimum number of available pods to ensure under the given active window.
+// The PodProtector must be summarized.
+func EffectiveMinAvailable(
+	ppr *podseidonv1a1.PodProtector,
+	window optional.Optional[*podseidonv1a1.PodProtectorWindow],
+) int32 {
+	if window, present := window.Get(); present && window.Action == podseidonv1a1.PodProtectorWindowActionOverride {
+		return resolveMinAvailable(window.MinAvailable, window.MaxUnavailable, ppr.Status.Summary.Total)
+	}
+
+	return ppr.Status.Summary.MinAvailable
+}
@@ -17,6 +17,7 @@ package retrybatch
 import (
 	"time"
 
+	"github.com/kubewharf/podseidon/util/optional"
 	"github.com/kubewharf/podseidon/util/util"
 )
 
@@ -25,6 +26,7 @@ type operationRequest[Arg any, Result any] struct {
 	retries  int
 	resultCh chan<- submitResult[Result]
 	ctxErr   func() error
+	deadline optional.Optional[time.Time]
 }
 
 // Result to be returned by Pool.Submit.
@@ -44,6 +44,8 @@ type Pool[Key comparable, Arg any, Result any] interface {
 	//
 	// Blocks until the operation completes or unrecoverably fails, or the operation context or the pool context is canceled.
 	// If the contexts are canceled, an error wrapping ctx.Err() is returned.
+	// If the operation context has a deadline that expires before the next retry,
+	// an error wrapping context.DeadlineExceeded is returned without waiting for the retry.
 	// Otherwise, the result from the underlying adapter is returned.
 	//
 	// A nil error will be returned if the operation has completed, even if the context may have timed out
@@ -169,12 +171,18 @@ func (pool *poolImpl[Key, Arg, Result, AdapterT]) Submit(
 	handle := pool.acquireLazyHandle(key)
 	defer handle.rc.Release() // the handle does not need to be released until execution is complete and batchGoroutine is idle.
 
+	deadline := optional.None[time.Time]()
+	if ctxDeadline, hasDeadline := ctx.Deadline(); hasDeadline {
+		deadline = optional.Some(ctxDeadline)
+	}
+
 	resultCh := make(chan submitResult[Result], 1)
 	request := operationRequest[Arg, Result]{
 		arg:      arg,
 		retries:  0,
 		resultCh: resultCh,
 		ctxErr:   ctx.Err,
+		deadline: deadline,
 	}
 	handle.operationCh <- request
 
@@ -330,6 +338,7 @@ func processBatch[
 			batch = append(batch, request)
 		case <-nextExecute:
 			if delay, shouldContinue := executeBatch(ctx, obs, adapter, syncBarrier, key, &batch).Get(); shouldContinue {
+				dropExpiringRequests(syncBarrier, &batch, delay)
 				nextExecute = syncBarrier.TimeAfter(delay)
 			} else {
 				flow = iter.Break
@@ -343,6 +352,33 @@ func processBatch[
 	obs.EndBatch(ctx, observer.EndBatch{RetryCount: retryCount})
 }
 
+// Fails the requests whose deadline expires before the next retry,
+// so that the caller can still make a decision before the deadline
+// instead of waiting for a retry that would be canceled anyway.
+func dropExpiringRequests[Key comparable, Arg any, Result any](
+	syncBarrier syncbarrier.Interface[Key],
+	batch *[]operationRequest[Arg, Result],
+	delay time.Duration,
+) {
+	nextExecuteTime := syncBarrier.Now().Add(delay)
+
+	util.DrainSliceUnordered(batch, func(request operationRequest[Arg, Result]) bool {
+		deadline, hasDeadline := request.deadline.Get()
+		if !hasDeadline || deadline.After(nextExecuteTime) {
+			return true
+		}
+
+		request.resultCh <- errorSubmitResult[Result](
+			request.retries,
+			errors.TagWrapf(
+				"DeadlineBeforeRetry", context.DeadlineExceeded, "operation deadline expires before the next retry",
+			),
+		)
+
+		return false
+	})
+}
+
 func executeBatch[
 	Key comparable, Arg any, Result any,
 	AdapterT Adapter[Key, Arg, Result],
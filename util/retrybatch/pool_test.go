@@ -25,6 +25,7 @@ import (
 	"github.com/stretchr/testify/require"
 	clocktesting "k8s.io/utils/clock/testing"
 
+	"github.com/kubewharf/podseidon/util/errors"
 	"github.com/kubewharf/podseidon/util/optional"
 	"github.com/kubewharf/podseidon/util/retrybatch"
 	"github.com/kubewharf/podseidon/util/util"
@@ -119,6 +120,10 @@ func (adapter *TestAdapter) Execute(
 	})
 }
 
+func (adapter *TestAdapter) Now() time.Time {
+	return adapter.clk.Now()
+}
+
 func (adapter *TestAdapter) TimeAfter(duration time.Duration) <-chan time.Time {
 	return adapter.clk.After(duration)
 }
@@ -257,6 +262,65 @@ func testSingleRetryInBubble(t *testing.T) {
 	assert.False(t, chOpen)
 }
 
+func TestDeadlineBeforeRetry(t *testing.T) {
+	t.Parallel()
+	synctest.Test(t, testDeadlineBeforeRetryInBubble)
+}
+
+func testDeadlineBeforeRetryInBubble(t *testing.T) {
+	const (
+		executeLatency = time.Second * 2
+		retryLatency   = time.Second * 4
+	)
+
+	defer synctest.Wait()
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	defer cancelFunc()
+
+	pool, adapter := newTestingPool(ctx, t)
+	adapter.clk.SetTime(time.Now()) // align with the bubble clock used by context deadlines
+
+	// The deadline expires after the first execution completes but before the retry.
+	submitCtx, submitCancelFunc := context.WithDeadline(
+		ctx,
+		adapter.clk.Now().Add(testColdStartDelay+executeLatency+retryLatency/2),
+	)
+	defer submitCancelFunc()
+
+	submitReturned := make(chan util.Empty)
+
+	go func(initialSetup <-chan time.Time) {
+		<-initialSetup
+
+		_, err := pool.Submit(submitCtx, key1, 1)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Contains(t, errors.GetTags(err), "DeadlineBeforeRetry")
+
+		close(submitReturned)
+	}(adapter.TimeAfter(time.Nanosecond))
+
+	adapter.step(0)
+
+	adapter.nextExec.Store(&Execution{
+		expectKey:       key1,
+		expectArgsLen:   1,
+		latency:         executeLatency,
+		retAcceptedArgs: optional.None[func(Arg) bool](),
+		retNeedRetry:    optional.Some(retryLatency),
+		retErr:          nil,
+	})
+
+	adapter.step(testColdStartDelay)
+	assert.Eventually(t, func() bool { return adapter.nextExec.Load() == nil }, time.Second, time.Millisecond)
+
+	// Submit returns as soon as the retry is scheduled, without waiting for the retry delay.
+	adapter.step(executeLatency)
+
+	_, chOpen := <-submitReturned
+	assert.False(t, chOpen)
+}
+
 const singleExecuteLatency = time.Second
 
 func doSingleExecute(adapter *TestAdapter) {
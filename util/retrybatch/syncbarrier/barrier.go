@@ -22,6 +22,7 @@ import (
 //
 // Should not be used externally.
 type Interface[Key any] interface {
+	Now() time.Time
 	TimeAfter(duration time.Duration) <-chan time.Time
 
 	StartCheckCanceled(key Key)
@@ -39,6 +40,7 @@ type Interface[Key any] interface {
 
 type Empty[Key any] struct{}
 
+func (Empty[Key]) Now() time.Time                                    { return time.Now() }
 func (Empty[Key]) TimeAfter(duration time.Duration) <-chan time.Time { return time.After(duration) }
 
 func (Empty[Key]) StartCheckCanceled(Key)  {}
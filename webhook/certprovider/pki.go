@@ -0,0 +1,292 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certprovider
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubewharf/podseidon/util/errors"
+)
+
+// Keys in the self-managed certificate secret.
+const (
+	SecretKeyServingCert = corev1.TLSCertKey
+	SecretKeyServingKey  = corev1.TLSPrivateKeyKey
+	SecretKeyCaCert      = "ca.crt"
+	SecretKeyCaKey       = "ca.key"
+	// All CA certificates that should be trusted by apiserver,
+	// i.e. the current CA and the previous CA until it expires.
+	SecretKeyCaBundle = "ca-bundle.crt"
+)
+
+type RotateConfig struct {
+	DnsNames     []string
+	CaValidity   time.Duration
+	CertValidity time.Duration
+	// Certificates are rotated when they expire within this duration.
+	RenewBefore time.Duration
+}
+
+type RotateResult struct {
+	Data           map[string][]byte
+	CaRotated      bool
+	ServingRotated bool
+	// Whether Data differs from the input, including changes to the CA bundle.
+	Changed bool
+}
+
+// Computes the desired secret data from the current data, rotating certificates that are missing,
+// invalid or expiring within `RenewBefore`.
+//
+// When the CA is rotated, the previous CA remains in the CA bundle until it expires,
+// so that apiserver instances still trust instances serving the previous certificate.
+func Rotate(data map[string][]byte, config RotateConfig, now time.Time) (_zero RotateResult, _ error) {
+	result := RotateResult{
+		Data:           map[string][]byte{},
+		CaRotated:      false,
+		ServingRotated: false,
+		Changed:        false,
+	}
+
+	caCertPem, caKeyPem := data[SecretKeyCaCert], data[SecretKeyCaKey]
+
+	caCert, err := parseCertPem(caCertPem)
+	if err != nil || expiresSoon(caCert, config.RenewBefore, now) || !isKeyPair(caCertPem, caKeyPem) {
+		caCertPem, caKeyPem, err = generateCa(config.CaValidity, now)
+		if err != nil {
+			return _zero, err
+		}
+
+		caCert, err = parseCertPem(caCertPem)
+		if err != nil {
+			return _zero, err
+		}
+
+		result.CaRotated = true
+	}
+
+	servingCertPem, servingKeyPem := data[SecretKeyServingCert], data[SecretKeyServingKey]
+
+	servingCert, err := parseCertPem(servingCertPem)
+	if err != nil ||
+		expiresSoon(servingCert, config.RenewBefore, now) ||
+		!isKeyPair(servingCertPem, servingKeyPem) ||
+		servingCert.CheckSignatureFrom(caCert) != nil ||
+		!slices.Equal(servingCert.DNSNames, config.DnsNames) {
+		servingCertPem, servingKeyPem, err = generateServing(caCertPem, caKeyPem, config, now)
+		if err != nil {
+			return _zero, err
+		}
+
+		result.ServingRotated = true
+	}
+
+	caBundle := slices.Clone(caCertPem)
+
+	// Retain previously trusted CAs that have not expired yet.
+	for _, trusted := range parseCertBundle(data[SecretKeyCaBundle]) {
+		if !bytes.Equal(trusted.Raw, caCert.Raw) && now.Before(trusted.NotAfter) {
+			caBundle = append(caBundle, encodeCertPem(trusted.Raw)...)
+		}
+	}
+
+	result.Data[SecretKeyCaCert] = caCertPem
+	result.Data[SecretKeyCaKey] = caKeyPem
+	result.Data[SecretKeyCaBundle] = caBundle
+	result.Data[SecretKeyServingCert] = servingCertPem
+	result.Data[SecretKeyServingKey] = servingKeyPem
+
+	for key, value := range result.Data {
+		if !bytes.Equal(data[key], value) {
+			result.Changed = true
+		}
+	}
+
+	return result, nil
+}
+
+func expiresSoon(cert *x509.Certificate, renewBefore time.Duration, now time.Time) bool {
+	return !now.Add(renewBefore).Before(cert.NotAfter)
+}
+
+func isKeyPair(certPem []byte, keyPem []byte) bool {
+	_, err := tls.X509KeyPair(certPem, keyPem)
+	return err == nil
+}
+
+func generateCa(validity time.Duration, now time.Time) (_certPem []byte, _keyPem []byte, _ error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, errors.TagWrapf("GenerateCaKey", err, "generate CA private key")
+	}
+
+	serial, err := randomSerial()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	//nolint:exhaustruct // only relevant fields are set
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "podseidon-webhook-ca"},
+		NotBefore:             now.Add(-time.Hour), // tolerate clock skew
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, errors.TagWrapf("CreateCaCert", err, "create CA certificate")
+	}
+
+	keyPem, err := encodeKeyPem(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return encodeCertPem(der), keyPem, nil
+}
+
+func generateServing(
+	caCertPem, caKeyPem []byte,
+	config RotateConfig,
+	now time.Time,
+) (_certPem []byte, _keyPem []byte, _ error) {
+	caPair, err := tls.X509KeyPair(caCertPem, caKeyPem)
+	if err != nil {
+		return nil, nil, errors.TagWrapf("ParseCa", err, "parse CA key pair")
+	}
+
+	caCert, err := x509.ParseCertificate(caPair.Certificate[0])
+	if err != nil {
+		return nil, nil, errors.TagWrapf("ParseCa", err, "parse CA certificate")
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, errors.TagWrapf("GenerateServingKey", err, "generate serving private key")
+	}
+
+	serial, err := randomSerial()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	notAfter := now.Add(config.CertValidity)
+	if notAfter.After(caCert.NotAfter) {
+		notAfter = caCert.NotAfter
+	}
+
+	commonName := "podseidon-webhook"
+	if len(config.DnsNames) > 0 {
+		commonName = config.DnsNames[0]
+	}
+
+	//nolint:exhaustruct // only relevant fields are set
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     config.DnsNames,
+		NotBefore:    now.Add(-time.Hour), // tolerate clock skew
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caPair.PrivateKey)
+	if err != nil {
+		return nil, nil, errors.TagWrapf("CreateServingCert", err, "create serving certificate")
+	}
+
+	keyPem, err := encodeKeyPem(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return encodeCertPem(der), keyPem, nil
+}
+
+//nolint:mnd // 128-bit serial numbers
+func randomSerial() (*big.Int, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.TagWrapf("GenerateSerial", err, "generate certificate serial number")
+	}
+
+	return serial, nil
+}
+
+func encodeCertPem(der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Headers: nil, Bytes: der})
+}
+
+func encodeKeyPem(key *ecdsa.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, errors.TagWrapf("MarshalKey", err, "marshal private key")
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Headers: nil, Bytes: der}), nil
+}
+
+func parseCertPem(certPem []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(certPem)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.TagErrorf("DecodeCertPem", "no PEM certificate found")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, errors.TagWrapf("ParseCert", err, "parse certificate")
+	}
+
+	return cert, nil
+}
+
+// Parses all valid certificates in a PEM bundle, skipping invalid blocks.
+func parseCertBundle(bundle []byte) []*x509.Certificate {
+	output := []*x509.Certificate{}
+
+	for {
+		var block *pem.Block
+
+		block, bundle = pem.Decode(bundle)
+		if block == nil {
+			return output
+		}
+
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		if cert, err := x509.ParseCertificate(block.Bytes); err == nil {
+			output = append(output, cert)
+		}
+	}
+}
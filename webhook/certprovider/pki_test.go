@@ -0,0 +1,174 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package certprovider_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kubewharf/podseidon/webhook/certprovider"
+)
+
+var testConfig = certprovider.RotateConfig{
+	DnsNames:     []string{"podseidon-webhook.default.svc"},
+	CaValidity:   time.Hour * 24 * 365,
+	CertValidity: time.Hour * 24 * 30,
+	RenewBefore:  time.Hour * 24 * 7,
+}
+
+var testEpoch = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func parseBundle(t *testing.T, bundle []byte) []*x509.Certificate {
+	t.Helper()
+
+	certs := []*x509.Certificate{}
+
+	for {
+		var block *pem.Block
+
+		block, bundle = pem.Decode(bundle)
+		if block == nil {
+			return certs
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		require.NoError(t, err)
+
+		certs = append(certs, cert)
+	}
+}
+
+func assertServingValid(t *testing.T, data map[string][]byte, now time.Time) {
+	t.Helper()
+
+	pair, err := tls.X509KeyPair(data[certprovider.SecretKeyServingCert], data[certprovider.SecretKeyServingKey])
+	require.NoError(t, err)
+
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	require.NoError(t, err)
+
+	roots := x509.NewCertPool()
+	require.True(t, roots.AppendCertsFromPEM(data[certprovider.SecretKeyCaBundle]))
+
+	//nolint:exhaustruct
+	_, err = leaf.Verify(x509.VerifyOptions{
+		DNSName:     testConfig.DnsNames[0],
+		Roots:       roots,
+		CurrentTime: now,
+	})
+	require.NoError(t, err)
+}
+
+func TestRotateFromEmpty(t *testing.T) {
+	t.Parallel()
+
+	result, err := certprovider.Rotate(nil, testConfig, testEpoch)
+	require.NoError(t, err)
+
+	assert.True(t, result.CaRotated)
+	assert.True(t, result.ServingRotated)
+	assert.True(t, result.Changed)
+	assert.Len(t, parseBundle(t, result.Data[certprovider.SecretKeyCaBundle]), 1)
+	assertServingValid(t, result.Data, testEpoch)
+}
+
+func TestRotateNoop(t *testing.T) {
+	t.Parallel()
+
+	initial, err := certprovider.Rotate(nil, testConfig, testEpoch)
+	require.NoError(t, err)
+
+	result, err := certprovider.Rotate(initial.Data, testConfig, testEpoch.Add(time.Hour*24))
+	require.NoError(t, err)
+
+	assert.False(t, result.CaRotated)
+	assert.False(t, result.ServingRotated)
+	assert.False(t, result.Changed)
+	assert.Equal(t, initial.Data, result.Data)
+}
+
+func TestRotateServingBeforeExpiry(t *testing.T) {
+	t.Parallel()
+
+	initial, err := certprovider.Rotate(nil, testConfig, testEpoch)
+	require.NoError(t, err)
+
+	now := testEpoch.Add(testConfig.CertValidity - testConfig.RenewBefore + time.Hour)
+
+	result, err := certprovider.Rotate(initial.Data, testConfig, now)
+	require.NoError(t, err)
+
+	assert.False(t, result.CaRotated)
+	assert.True(t, result.ServingRotated)
+	assert.True(t, result.Changed)
+	assert.Equal(t, initial.Data[certprovider.SecretKeyCaBundle], result.Data[certprovider.SecretKeyCaBundle])
+	assertServingValid(t, result.Data, now)
+}
+
+func TestRotateCaRetainsPreviousCa(t *testing.T) {
+	t.Parallel()
+
+	initial, err := certprovider.Rotate(nil, testConfig, testEpoch)
+	require.NoError(t, err)
+
+	now := testEpoch.Add(testConfig.CaValidity - testConfig.RenewBefore + time.Hour)
+
+	result, err := certprovider.Rotate(initial.Data, testConfig, now)
+	require.NoError(t, err)
+
+	assert.True(t, result.CaRotated)
+	assert.True(t, result.ServingRotated)
+
+	bundle := parseBundle(t, result.Data[certprovider.SecretKeyCaBundle])
+	require.Len(t, bundle, 2)
+
+	previousCa := parseBundle(t, initial.Data[certprovider.SecretKeyCaCert])[0]
+	assert.Equal(t, previousCa.Raw, bundle[1].Raw, "previous CA should remain trusted until it expires")
+	assertServingValid(t, result.Data, now)
+
+	// The previous CA is dropped from the bundle after expiry.
+	afterExpiry := previousCa.NotAfter.Add(time.Hour)
+
+	result, err = certprovider.Rotate(result.Data, testConfig, afterExpiry)
+	require.NoError(t, err)
+
+	assert.False(t, result.CaRotated)
+	assert.Len(t, parseBundle(t, result.Data[certprovider.SecretKeyCaBundle]), 1)
+}
+
+func TestRotateDnsNamesChanged(t *testing.T) {
+	t.Parallel()
+
+	initial, err := certprovider.Rotate(nil, testConfig, testEpoch)
+	require.NoError(t, err)
+
+	config := testConfig
+	config.DnsNames = []string{"podseidon-webhook.podseidon-system.svc"}
+
+	result, err := certprovider.Rotate(initial.Data, config, testEpoch)
+	require.NoError(t, err)
+
+	assert.False(t, result.CaRotated)
+	assert.True(t, result.ServingRotated)
+
+	leaf := parseBundle(t, result.Data[certprovider.SecretKeyServingCert])[0]
+	assert.Equal(t, config.DnsNames, leaf.DNSNames)
+}
@@ -0,0 +1,401 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Provides serving certificates for the webhook HTTPS server.
+//
+// The `self-managed` implementation generates a CA and a serving certificate,
+// stores them in a Secret shared by all webhook replicas,
+// and writes them to local files that are hot-reloaded by the HTTPS server.
+// The replica holding the leader lease rotates them before expiry
+// and keeps the caBundle of the ValidatingWebhookConfiguration in sync.
+package certprovider
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/utils/clock"
+
+	"github.com/kubewharf/podseidon/util/component"
+	"github.com/kubewharf/podseidon/util/errors"
+	utilflag "github.com/kubewharf/podseidon/util/flag"
+	"github.com/kubewharf/podseidon/util/kube"
+	"github.com/kubewharf/podseidon/util/o11y"
+	"github.com/kubewharf/podseidon/util/util"
+
+	"github.com/kubewharf/podseidon/webhook/observer"
+)
+
+const MuxName = "webhook-cert-provider"
+
+const (
+	CoreClusterName kube.ClusterName = "core"
+	ElectorName     kube.ElectorName = "webhook-cert"
+)
+
+// Requested by the webhook server so that self-managed certificate files
+// are written before the HTTPS server loads them.
+var Request = component.ProvideMux[Provider](
+	MuxName,
+	"source of webhook serving certificates",
+)
+
+type Provider interface {
+	// Whether the certificate files are written by this process.
+	IsSelfManaged() bool
+}
+
+var DefaultImpls = component.RequireDeps(
+	Files,
+	SelfManaged(SelfManagedArgs{Clock: clock.RealClock{}}, false),
+)
+
+type filesProvider struct{}
+
+func (filesProvider) IsSelfManaged() bool { return false }
+
+// Certificate files are provisioned externally, e.g. from a mounted Secret.
+var Files = component.DeclareMuxImpl(
+	MuxName,
+	func(util.Empty) string { return "files" },
+	func(util.Empty, *flag.FlagSet) util.Empty { return util.Empty{} },
+	func(util.Empty, *component.DepRequests) util.Empty { return util.Empty{} },
+	func(context.Context, util.Empty, util.Empty, util.Empty) (*util.Empty, error) {
+		return &util.Empty{}, nil
+	},
+	component.Lifecycle[util.Empty, util.Empty, util.Empty, util.Empty]{Start: nil, Join: nil, HealthChecks: nil},
+	func(*component.Data[util.Empty, util.Empty, util.Empty, util.Empty]) Provider { return filesProvider{} },
+)(util.Empty{}, true)
+
+var SelfManaged = component.DeclareMuxImpl(
+	MuxName,
+	func(SelfManagedArgs) string { return "self-managed" },
+	func(_ SelfManagedArgs, fs *flag.FlagSet) SelfManagedOptions {
+		return SelfManagedOptions{
+			SecretNamespace: fs.String("secret-namespace", metav1.NamespaceDefault, "namespace of the certificate secret"),
+			SecretName:      fs.String("secret-name", "podseidon-webhook-tls", "name of the certificate secret"),
+			WebhookConfigName: fs.String(
+				"webhook-config-name",
+				"podseidon.kubewharf.io",
+				"name of the ValidatingWebhookConfiguration in the core cluster to inject caBundle into, empty to disable",
+			),
+			DnsNames: utilflag.StringSlice(
+				fs,
+				"dns-names",
+				[]string{"podseidon-webhook.default.svc"},
+				"DNS names of the serving certificate",
+			),
+			CertDir: fs.String(
+				"cert-dir",
+				"/var/run/podseidon/webhook-tls",
+				"directory to write tls.crt and tls.key into, to be used as the HTTPS cert/key files",
+			),
+			CaValidity:   fs.Duration("ca-validity", time.Hour*24*365*5, "validity period of the generated CA"),
+			CertValidity: fs.Duration("cert-validity", time.Hour*24*90, "validity period of the generated serving certificate"),
+			RenewBefore: fs.Duration(
+				"renew-before",
+				time.Hour*24*30,
+				"rotate certificates when they expire within this duration",
+			),
+			SyncInterval: fs.Duration("sync-interval", time.Minute, "interval to resync the secret and check for expiry"),
+		}
+	},
+	func(_ SelfManagedArgs, requests *component.DepRequests) SelfManagedDeps {
+		return SelfManagedDeps{
+			client: component.DepPtr(requests, kube.NewClient(kube.ClientArgs{
+				ClusterName: CoreClusterName,
+			})),
+			elector: component.DepPtr(requests, kube.NewElector(kube.ElectorArgs{
+				ElectorName: ElectorName,
+				ClusterName: CoreClusterName,
+			})),
+			observer: o11y.Request[observer.Observer](requests),
+		}
+	},
+	func(ctx context.Context, args SelfManagedArgs, options SelfManagedOptions, deps SelfManagedDeps) (*selfManagedState, error) {
+		state := &selfManagedState{
+			clock:     args.Clock,
+			options:   options,
+			clientSet: deps.client.Get().NativeClientSet(),
+			elector:   deps.elector.Get(),
+			observer:  deps.observer.Get(),
+		}
+
+		// Every replica may bootstrap the secret.
+		// Create is atomic, so only one of the concurrently generated certificates is persisted.
+		secret, err := state.getOrCreateSecret(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := state.writeFiles(secret); err != nil {
+			return nil, err
+		}
+
+		return state, nil
+	},
+	component.Lifecycle[SelfManagedArgs, SelfManagedOptions, SelfManagedDeps, selfManagedState]{
+		Start: func(ctx context.Context, _ *SelfManagedArgs, _ *SelfManagedOptions, _ *SelfManagedDeps, state *selfManagedState) error {
+			go wait.UntilWithContext(ctx, state.syncFiles, *state.options.SyncInterval)
+
+			go func() {
+				leaderCtx, err := state.elector.Await(ctx)
+				if err != nil {
+					return
+				}
+
+				wait.UntilWithContext(leaderCtx, state.rotate, *state.options.SyncInterval)
+			}()
+
+			return nil
+		},
+		Join:         nil,
+		HealthChecks: nil,
+	},
+	func(*component.Data[SelfManagedArgs, SelfManagedOptions, SelfManagedDeps, selfManagedState]) Provider {
+		return selfManagedProvider{}
+	},
+)
+
+type SelfManagedArgs struct {
+	Clock clock.Clock
+}
+
+type SelfManagedOptions struct {
+	SecretNamespace   *string
+	SecretName        *string
+	WebhookConfigName *string
+	DnsNames          *[]string
+	CertDir           *string
+	CaValidity        *time.Duration
+	CertValidity      *time.Duration
+	RenewBefore       *time.Duration
+	SyncInterval      *time.Duration
+}
+
+type SelfManagedDeps struct {
+	client   component.Dep[*kube.Client]
+	elector  component.Dep[*kube.Elector]
+	observer component.Dep[observer.Observer]
+}
+
+type selfManagedState struct {
+	clock     clock.Clock
+	options   SelfManagedOptions
+	clientSet kubernetes.Interface
+	elector   *kube.Elector
+	observer  observer.Observer
+}
+
+type selfManagedProvider struct{}
+
+func (selfManagedProvider) IsSelfManaged() bool { return true }
+
+func (state *selfManagedState) rotateConfig() RotateConfig {
+	return RotateConfig{
+		DnsNames:     *state.options.DnsNames,
+		CaValidity:   *state.options.CaValidity,
+		CertValidity: *state.options.CertValidity,
+		RenewBefore:  *state.options.RenewBefore,
+	}
+}
+
+func (state *selfManagedState) secretClient() corev1client.SecretInterface {
+	return state.clientSet.CoreV1().Secrets(*state.options.SecretNamespace)
+}
+
+func (state *selfManagedState) getOrCreateSecret(ctx context.Context) (*corev1.Secret, error) {
+	secret, err := state.secretClient().Get(ctx, *state.options.SecretName, metav1.GetOptions{})
+	if err == nil {
+		return secret, nil
+	}
+
+	if !apierrors.IsNotFound(err) {
+		return nil, errors.TagWrapf("GetSecret", err, "get certificate secret")
+	}
+
+	result, err := Rotate(nil, state.rotateConfig(), state.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	//nolint:exhaustruct // leave other fields as default
+	secret = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: *state.options.SecretNamespace,
+			Name:      *state.options.SecretName,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: result.Data,
+	}
+
+	created, err := state.secretClient().Create(ctx, secret, metav1.CreateOptions{})
+	if err == nil {
+		return created, nil
+	}
+
+	if !apierrors.IsAlreadyExists(err) {
+		return nil, errors.TagWrapf("CreateSecret", err, "create certificate secret")
+	}
+
+	// Another replica created the secret concurrently.
+	secret, err = state.secretClient().Get(ctx, *state.options.SecretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.TagWrapf("GetSecret", err, "get certificate secret")
+	}
+
+	return secret, nil
+}
+
+// Writes the serving certificate to the cert dir if it has changed.
+func (state *selfManagedState) writeFiles(secret *corev1.Secret) error {
+	if err := os.MkdirAll(*state.options.CertDir, 0o700); err != nil {
+		return errors.TagWrapf("MkdirCertDir", err, "create cert dir")
+	}
+
+	// Write the key first, since the cert file is usually the one users check for freshness.
+	for _, key := range []string{SecretKeyServingKey, SecretKeyServingCert} {
+		if err := writeFileIfChanged(filepath.Join(*state.options.CertDir, key), secret.Data[key]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeFileIfChanged(path string, content []byte) error {
+	if existing, err := os.ReadFile(path); err == nil && bytes.Equal(existing, content) {
+		return nil
+	}
+
+	// Rename is atomic, so readers never observe a partially written file.
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, 0o600); err != nil {
+		return errors.TagWrapf("WriteCertFile", err, "write %s", tmpPath)
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return errors.TagWrapf("RenameCertFile", err, "rename %s", tmpPath)
+	}
+
+	return nil
+}
+
+func (state *selfManagedState) syncFiles(ctx context.Context) {
+	secret, err := state.secretClient().Get(ctx, *state.options.SecretName, metav1.GetOptions{})
+	if err == nil {
+		err = state.writeFiles(secret)
+	}
+
+	if err != nil {
+		state.observer.SyncSelfManagedCert(ctx, observer.SyncSelfManagedCert{
+			CaRotated:       false,
+			ServingRotated:  false,
+			CaBundlePatched: false,
+			Err:             errors.TagWrapf("SyncFiles", err, "sync certificate files from secret"),
+		})
+	}
+}
+
+func (state *selfManagedState) rotate(ctx context.Context) {
+	event := observer.SyncSelfManagedCert{
+		CaRotated:       false,
+		ServingRotated:  false,
+		CaBundlePatched: false,
+		Err:             nil,
+	}
+
+	event.Err = state.tryRotate(ctx, &event)
+	state.observer.SyncSelfManagedCert(ctx, event)
+}
+
+func (state *selfManagedState) tryRotate(ctx context.Context, event *observer.SyncSelfManagedCert) error {
+	secret, err := state.getOrCreateSecret(ctx)
+	if err != nil {
+		return err
+	}
+
+	result, err := Rotate(secret.Data, state.rotateConfig(), state.clock.Now())
+	if err != nil {
+		return err
+	}
+
+	// Trust the new CA before serving certificates signed by it.
+	patched, err := state.patchCaBundle(ctx, result.Data[SecretKeyCaBundle])
+	if err != nil {
+		return err
+	}
+
+	event.CaBundlePatched = patched
+
+	if !result.Changed {
+		return nil
+	}
+
+	secret = secret.DeepCopy()
+	secret.Data = result.Data
+
+	// Conflicts are retried in the next sync.
+	if _, err := state.secretClient().Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		return errors.TagWrapf("UpdateSecret", err, "update certificate secret")
+	}
+
+	event.CaRotated = result.CaRotated
+	event.ServingRotated = result.ServingRotated
+
+	return nil
+}
+
+func (state *selfManagedState) patchCaBundle(ctx context.Context, caBundle []byte) (_patched bool, _ error) {
+	if *state.options.WebhookConfigName == "" {
+		return false, nil
+	}
+
+	client := state.clientSet.AdmissionregistrationV1().ValidatingWebhookConfigurations()
+
+	config, err := client.Get(ctx, *state.options.WebhookConfigName, metav1.GetOptions{})
+	if err != nil {
+		return false, errors.TagWrapf("GetWebhookConfig", err, "get ValidatingWebhookConfiguration")
+	}
+
+	changed := false
+
+	for i := range config.Webhooks {
+		clientConfig := &config.Webhooks[i].ClientConfig
+		if !bytes.Equal(clientConfig.CABundle, caBundle) {
+			clientConfig.CABundle = caBundle
+			changed = true
+		}
+	}
+
+	if !changed {
+		return false, nil
+	}
+
+	if _, err := client.Update(ctx, config, metav1.UpdateOptions{}); err != nil {
+		return false, errors.TagWrapf("UpdateWebhookConfig", err, "update caBundle of ValidatingWebhookConfiguration")
+	}
+
+	return true, nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	podseidon "github.com/kubewharf/podseidon/apis"
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
+
+	"github.com/kubewharf/podseidon/util/errors"
+	utilflag "github.com/kubewharf/podseidon/util/flag"
+	"github.com/kubewharf/podseidon/util/optional"
+
+	"github.com/kubewharf/podseidon/webhook/observer"
+)
+
+// The verdict returned when the admission deadline expires before the quota is reserved.
+type DeadlineVerdict uint8
+
+const (
+	// Advise the client to retry, same as a full admission buffer.
+	DeadlineVerdictRetry DeadlineVerdict = iota
+	// Deny the request, same as insufficient availability.
+	DeadlineVerdictDeny
+)
+
+func (verdict DeadlineVerdict) String() string {
+	if verdict == DeadlineVerdictDeny {
+		return "deny"
+	}
+
+	return "retry"
+}
+
+func deadlineVerdictFlag(fs *flag.FlagSet) *DeadlineVerdict {
+	return utilflag.EnumFromMap(map[string]DeadlineVerdict{
+		DeadlineVerdictRetry.String(): DeadlineVerdictRetry,
+		DeadlineVerdictDeny.String():  DeadlineVerdictDeny,
+	}).
+		TypeName("verdict").
+		Default(DeadlineVerdictRetry.String()).
+		Flag(fs, "deadline-verdict", "response when the admission deadline expires before quota is reserved")
+}
+
+// Whether err is caused by the request context reaching its deadline,
+// including retrybatch operations that would not be retried before the deadline.
+func isDeadlineExceeded(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded)
+}
+
+// Converts a deadline expiry during quota reservation into the configured verdict,
+// so that apiserver receives a deliberate response instead of applying the failure policy.
+func (api Api) deadlineExceededResult(
+	ctx context.Context,
+	subject RejectionSubject,
+	pod *corev1.Pod,
+	cellId string,
+	kind reviewKind,
+	err error,
+) HandleResult {
+	event := observer.DeadlineExceeded{
+		Namespace: subject.Namespace,
+		PprName:   "",
+		GroupName: "",
+		PodName:   pod.Name,
+		PodCell:   cellId,
+		Verdict:   api.deadlineVerdict.String(),
+		Err:       err,
+	}
+
+	if subject.Kind == podseidonv1a1.PodProtectorGroupKind {
+		event.GroupName = subject.Name
+	} else {
+		event.PprName = subject.Name
+	}
+
+	api.observer.DeadlineExceeded(ctx, event)
+
+	rejection := Rejection{
+		Code: retryCode(kind),
+		Message: fmt.Sprintf(
+			"%s could not reserve quota for pod deletion before the admission deadline",
+			subject.DisplayName(),
+		),
+		Reason:            podseidon.RejectionReasonDeadlineExceeded,
+		Subject:           optional.Some(subject),
+		Quota:             optional.None[RejectionQuota](),
+		RetryAfterSeconds: 1,
+	}
+
+	if api.deadlineVerdict == DeadlineVerdictDeny {
+		rejection.Code = deniedCode(kind)
+		rejection.RetryAfterSeconds = 0
+	}
+
+	return HandleResult{
+		Status:    observer.RequestStatusDeadlineExceeded,
+		Rejection: optional.Some(rejection),
+		Err:       nil,
+		Warnings:  nil,
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"os"
+
+	"github.com/kubewharf/podseidon/util/component"
+	"github.com/kubewharf/podseidon/util/errors"
+	"github.com/kubewharf/podseidon/util/util"
+)
+
+const DeleterIdentityMuxName = "webhook-deleter-identity"
+
+var RequestDeleterIdentity = component.ProvideMux[DeleterIdentity](
+	DeleterIdentityMuxName,
+	"how the identity of the deleting user is written into PodProtector admission history",
+)
+
+// Determines the user identity written into PodProtector admission history.
+type DeleterIdentity interface {
+	// Returns the identity to record, or an empty string if no identity should be recorded.
+	DeleterIdentity(arg DeleterIdentityArg) string
+}
+
+type DeleterIdentityArg struct {
+	CellId   string
+	Username string
+}
+
+var DefaultDeleterIdentityImpls = component.RequireDeps(
+	NoneDeleterIdentity,
+	UsernameDeleterIdentity,
+	HashDeleterIdentity,
+)
+
+type deleterIdentityMode uint8
+
+const (
+	deleterIdentityModeNone deleterIdentityMode = iota
+	deleterIdentityModeUsername
+)
+
+func (mode deleterIdentityMode) DeleterIdentity(arg DeleterIdentityArg) string {
+	switch mode {
+	case deleterIdentityModeUsername:
+		return arg.Username
+	default:
+		return ""
+	}
+}
+
+var provideDeleterIdentity = component.DeclareMuxImpl(
+	DeleterIdentityMuxName,
+	func(mode deleterIdentityMode) string {
+		switch mode {
+		case deleterIdentityModeUsername:
+			return "username"
+		default:
+			return "none"
+		}
+	},
+	func(deleterIdentityMode, *flag.FlagSet) util.Empty { return util.Empty{} },
+	func(deleterIdentityMode, *component.DepRequests) util.Empty { return util.Empty{} },
+	func(context.Context, deleterIdentityMode, util.Empty, util.Empty) (*util.Empty, error) {
+		return &util.Empty{}, nil
+	},
+	component.Lifecycle[deleterIdentityMode, util.Empty, util.Empty, util.Empty]{Start: nil, Join: nil, HealthChecks: nil},
+	func(d *component.Data[deleterIdentityMode, util.Empty, util.Empty, util.Empty]) DeleterIdentity {
+		return d.Args
+	},
+)
+
+var NoneDeleterIdentity = provideDeleterIdentity(deleterIdentityModeNone, true)
+
+var UsernameDeleterIdentity = provideDeleterIdentity(deleterIdentityModeUsername, false)
+
+// Number of hex digits retained from the HMAC-SHA256 digest of a username.
+const deleterIdentityHashLength = 16
+
+// Records a truncated HMAC-SHA256 of the username keyed with a secret,
+// so that readers of the PodProtector status cannot recover usernames by hashing candidate names
+// without access to the key.
+var HashDeleterIdentity = component.DeclareMuxImpl(
+	DeleterIdentityMuxName,
+	func(util.Empty) string { return "hash" },
+	func(_ util.Empty, fs *flag.FlagSet) HashDeleterIdentityOptions {
+		return HashDeleterIdentityOptions{
+			KeyFile: fs.String(
+				"key-file",
+				"",
+				"path to a file containing the secret HMAC key for hashing usernames; required",
+			),
+		}
+	},
+	func(util.Empty, *component.DepRequests) util.Empty { return util.Empty{} },
+	func(_ context.Context, _ util.Empty, options HashDeleterIdentityOptions, _ util.Empty) (*hashDeleterIdentity, error) {
+		if *options.KeyFile == "" {
+			return nil, errors.TagErrorf("MissingHmacKey", "key-file must be specified for hashed deleter identities")
+		}
+
+		key, err := os.ReadFile(*options.KeyFile)
+		if err != nil {
+			return nil, errors.TagWrapf("ReadHmacKey", err, "read HMAC key file")
+		}
+
+		key = bytes.TrimSpace(key)
+		if len(key) == 0 {
+			return nil, errors.TagErrorf("EmptyHmacKey", "HMAC key file %q is empty", *options.KeyFile)
+		}
+
+		return &hashDeleterIdentity{key: key}, nil
+	},
+	component.Lifecycle[util.Empty, HashDeleterIdentityOptions, util.Empty, hashDeleterIdentity]{
+		Start:        nil,
+		Join:         nil,
+		HealthChecks: nil,
+	},
+	func(d *component.Data[util.Empty, HashDeleterIdentityOptions, util.Empty, hashDeleterIdentity]) DeleterIdentity {
+		return d.State
+	},
+)(util.Empty{}, false)
+
+type HashDeleterIdentityOptions struct {
+	KeyFile *string
+}
+
+type hashDeleterIdentity struct {
+	key []byte
+}
+
+func (identity *hashDeleterIdentity) DeleterIdentity(arg DeleterIdentityArg) string {
+	mac := hmac.New(sha256.New, identity.key)
+	_, _ = mac.Write([]byte(arg.Username))
+
+	return hex.EncodeToString(mac.Sum(nil))[:deleterIdentityHashLength]
+}
@@ -0,0 +1,268 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"os"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/kubewharf/podseidon/util/component"
+	"github.com/kubewharf/podseidon/util/errors"
+	"github.com/kubewharf/podseidon/util/o11y"
+	"github.com/kubewharf/podseidon/util/optional"
+	"github.com/kubewharf/podseidon/util/util"
+
+	"github.com/kubewharf/podseidon/webhook/observer"
+)
+
+// Selects requests that bypass or relax PodProtector enforcement,
+// e.g. break-glass access for an incident response group.
+var NewExemption = component.Declare(
+	func(util.Empty) string { return "webhook-exemption" },
+	func(_ util.Empty, fs *flag.FlagSet) ExemptionOptions {
+		return ExemptionOptions{
+			Rules: fs.String(
+				"rules",
+				"",
+				"JSON array of exemption rules, evaluated before the rules in --webhook-exemption-rules-file",
+			),
+			RulesFile: fs.String(
+				"rules-file",
+				"",
+				"path to a JSON file containing an array of exemption rules, e.g. mounted from a ConfigMap; reloaded on change",
+			),
+			ReloadInterval: fs.Duration("reload-interval", time.Second*30, "interval to check --webhook-exemption-rules-file for changes"),
+		}
+	},
+	func(_ util.Empty, requests *component.DepRequests) ExemptionDeps {
+		return ExemptionDeps{
+			observer: o11y.Request[observer.Observer](requests),
+		}
+	},
+	func(_ context.Context, _ util.Empty, options ExemptionOptions, deps ExemptionDeps) (*ExemptionState, error) {
+		flagRules, err := ParseExemptionRules([]byte(*options.Rules))
+		if err != nil {
+			return nil, errors.TagWrapf("ParseRulesFlag", err, "parse --webhook-exemption-rules")
+		}
+
+		state := &ExemptionState{
+			options:   options,
+			observer:  deps.observer.Get(),
+			flagRules: flagRules,
+			fileRules: atomic.Pointer[[]CompiledExemptionRule]{},
+			fileBytes: nil,
+		}
+		state.fileRules.Store(&[]CompiledExemptionRule{})
+
+		if *options.RulesFile != "" {
+			if _, err := state.reloadFile(); err != nil {
+				return nil, err
+			}
+		}
+
+		return state, nil
+	},
+	component.Lifecycle[util.Empty, ExemptionOptions, ExemptionDeps, ExemptionState]{
+		Start: func(ctx context.Context, _ *util.Empty, options *ExemptionOptions, _ *ExemptionDeps, state *ExemptionState) error {
+			if *options.RulesFile != "" {
+				go wait.UntilWithContext(ctx, state.reload, *options.ReloadInterval)
+			}
+
+			return nil
+		},
+		Join:         nil,
+		HealthChecks: nil,
+	},
+	func(d *component.Data[util.Empty, ExemptionOptions, ExemptionDeps, ExemptionState]) *ExemptionState {
+		return d.State
+	},
+)
+
+type ExemptionOptions struct {
+	Rules          *string
+	RulesFile      *string
+	ReloadInterval *time.Duration
+}
+
+type ExemptionDeps struct {
+	observer component.Dep[observer.Observer]
+}
+
+type ExemptionState struct {
+	options  ExemptionOptions
+	observer observer.Observer
+
+	flagRules []CompiledExemptionRule
+	fileRules atomic.Pointer[[]CompiledExemptionRule]
+	// Only accessed from the reload goroutine.
+	fileBytes []byte
+}
+
+type ExemptionAction string
+
+const (
+	// Allow the request without reserving any quota.
+	ExemptionActionAlwaysAllow = ExemptionAction("AlwaysAllow")
+	// Reserve quota and emit rejection metrics as usual, but never reject the request,
+	// equivalent to the force-delete annotation on the pod.
+	ExemptionActionDryRun = ExemptionAction("DryRun")
+	// Handle the request normally.
+	// Useful for excluding a subset of requests from a later, broader rule.
+	ExemptionActionEnforce = ExemptionAction("Enforce")
+)
+
+// A rule matches a request if all of its non-empty criteria match.
+// Each list criterion matches if any of its items match.
+type ExemptionRule struct {
+	// Identifies the rule in audit annotations and observability.
+	Name string `json:"name"`
+
+	Usernames   []string              `json:"usernames,omitempty"`
+	Groups      []string              `json:"groups,omitempty"`
+	Namespaces  []string              `json:"namespaces,omitempty"`
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+
+	Action ExemptionAction `json:"action"`
+}
+
+type CompiledExemptionRule struct {
+	ExemptionRule
+	podSelector labels.Selector
+}
+
+// Parses and validates a JSON array of exemption rules.
+// Empty input is treated as an empty array.
+func ParseExemptionRules(input []byte) ([]CompiledExemptionRule, error) {
+	if len(bytes.TrimSpace(input)) == 0 {
+		return []CompiledExemptionRule{}, nil
+	}
+
+	var rules []ExemptionRule
+	if err := json.Unmarshal(input, &rules); err != nil {
+		return nil, errors.TagWrapf("UnmarshalRules", err, "exemption rules must be a JSON array")
+	}
+
+	compiled := make([]CompiledExemptionRule, 0, len(rules))
+
+	for _, rule := range rules {
+		if rule.Name == "" {
+			return nil, errors.TagErrorf("EmptyRuleName", "exemption rule name must not be empty")
+		}
+
+		switch rule.Action {
+		case ExemptionActionAlwaysAllow, ExemptionActionDryRun, ExemptionActionEnforce:
+		default:
+			return nil, errors.TagErrorf("UnknownAction", "unknown action %q in exemption rule %q", rule.Action, rule.Name)
+		}
+
+		if len(rule.Usernames) == 0 && len(rule.Groups) == 0 && len(rule.Namespaces) == 0 && rule.PodSelector == nil {
+			// A rule without criteria would exempt every request, which is more likely a mistake than intended.
+			return nil, errors.TagErrorf("NoCriteria", "exemption rule %q must specify at least one criterion", rule.Name)
+		}
+
+		podSelector := labels.Everything()
+
+		if rule.PodSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(rule.PodSelector)
+			if err != nil {
+				return nil, errors.TagWrapf("ParsePodSelector", err, "invalid podSelector in exemption rule %q", rule.Name)
+			}
+
+			podSelector = selector
+		}
+
+		compiled = append(compiled, CompiledExemptionRule{ExemptionRule: rule, podSelector: podSelector})
+	}
+
+	return compiled, nil
+}
+
+func (rule *CompiledExemptionRule) Matches(user authenticationv1.UserInfo, pod *corev1.Pod) bool {
+	if len(rule.Usernames) > 0 && !slices.Contains(rule.Usernames, user.Username) {
+		return false
+	}
+
+	if len(rule.Groups) > 0 && !slices.ContainsFunc(user.Groups, func(group string) bool {
+		return slices.Contains(rule.Groups, group)
+	}) {
+		return false
+	}
+
+	if len(rule.Namespaces) > 0 && !slices.Contains(rule.Namespaces, pod.Namespace) {
+		return false
+	}
+
+	return rule.podSelector.Matches(labels.Set(pod.Labels))
+}
+
+// Returns the first rule matching the request.
+// Rules from the flag are evaluated before rules from the file.
+func (state *ExemptionState) Match(user authenticationv1.UserInfo, pod *corev1.Pod) optional.Optional[ExemptionRule] {
+	for _, rules := range [][]CompiledExemptionRule{state.flagRules, *state.fileRules.Load()} {
+		for i := range rules {
+			if rules[i].Matches(user, pod) {
+				return optional.Some(rules[i].ExemptionRule)
+			}
+		}
+	}
+
+	return optional.None[ExemptionRule]()
+}
+
+func (state *ExemptionState) reload(ctx context.Context) {
+	count, err := state.reloadFile()
+	if count.IsSome() || err != nil {
+		state.observer.ReloadExemptionRules(ctx, observer.ReloadExemptionRules{
+			Path:      *state.options.RulesFile,
+			RuleCount: count.GetOrZero(),
+			Err:       err,
+		})
+	}
+}
+
+// Reloads the rules file if it has changed, returning the new number of rules.
+// The previous rules remain effective if the file is invalid.
+func (state *ExemptionState) reloadFile() (optional.Optional[int], error) {
+	content, err := os.ReadFile(*state.options.RulesFile)
+	if err != nil {
+		return optional.None[int](), errors.TagWrapf("ReadRulesFile", err, "read exemption rules file")
+	}
+
+	if state.fileBytes != nil && bytes.Equal(content, state.fileBytes) {
+		return optional.None[int](), nil
+	}
+
+	rules, err := ParseExemptionRules(content)
+	if err != nil {
+		return optional.None[int](), errors.TagWrapf("ParseRulesFile", err, "parse exemption rules file")
+	}
+
+	state.fileBytes = content
+	state.fileRules.Store(&rules)
+
+	return optional.Some(len(rules)), nil
+}
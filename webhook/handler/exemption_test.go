@@ -0,0 +1,88 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kubewharf/podseidon/webhook/handler"
+)
+
+func TestParseExemptionRulesValidation(t *testing.T) {
+	t.Parallel()
+
+	for name, input := range map[string]string{
+		"not an array":   `{"name": "a"}`,
+		"empty name":     `[{"action": "AlwaysAllow", "groups": ["sre"]}]`,
+		"unknown action": `[{"name": "a", "action": "Allow", "groups": ["sre"]}]`,
+		"no criteria":    `[{"name": "a", "action": "AlwaysAllow"}]`,
+		"bad selector":   `[{"name": "a", "action": "DryRun", "podSelector": {"matchLabels": {"/": "x"}}}]`,
+	} {
+		_, err := handler.ParseExemptionRules([]byte(input))
+		assert.Error(t, err, name)
+	}
+
+	rules, err := handler.ParseExemptionRules([]byte("  "))
+	require.NoError(t, err)
+	assert.Empty(t, rules)
+}
+
+func TestExemptionRuleMatches(t *testing.T) {
+	t.Parallel()
+
+	rules, err := handler.ParseExemptionRules([]byte(`[
+		{"name": "sre-staging", "action": "Enforce", "groups": ["sre"], "namespaces": ["staging"]},
+		{"name": "sre", "action": "AlwaysAllow", "groups": ["sre", "oncall"]},
+		{"name": "node-lifecycle", "action": "DryRun",
+			"usernames": ["system:serviceaccount:kube-system:node-controller"],
+			"podSelector": {"matchExpressions": [{"key": "critical", "operator": "DoesNotExist"}]}}
+	]`))
+	require.NoError(t, err)
+
+	//nolint:exhaustruct
+	pod := func(namespace string, podLabels map[string]string) *corev1.Pod {
+		return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Labels: podLabels}}
+	}
+
+	//nolint:exhaustruct
+	sre := authenticationv1.UserInfo{Username: "alice", Groups: []string{"system:authenticated", "oncall"}}
+	//nolint:exhaustruct
+	nodeController := authenticationv1.UserInfo{Username: "system:serviceaccount:kube-system:node-controller"}
+
+	firstMatch := func(user authenticationv1.UserInfo, pod *corev1.Pod) string {
+		for i := range rules {
+			if rules[i].Matches(user, pod) {
+				return rules[i].Name
+			}
+		}
+
+		return ""
+	}
+
+	assert.Equal(t, "sre", firstMatch(sre, pod("prod", nil)))
+	assert.Equal(t, "sre", firstMatch(sre, pod("staging", nil)), "sre-staging requires the sre group")
+	sre.Groups = append(sre.Groups, "sre")
+	assert.Equal(t, "sre-staging", firstMatch(sre, pod("staging", nil)))
+	//nolint:exhaustruct
+	assert.Empty(t, firstMatch(authenticationv1.UserInfo{Username: "bob"}, pod("prod", nil)))
+	assert.Equal(t, "node-lifecycle", firstMatch(nodeController, pod("prod", map[string]string{"app": "x"})))
+	assert.Empty(t, firstMatch(nodeController, pod("prod", map[string]string{"critical": "true"})))
+}
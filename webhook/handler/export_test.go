@@ -0,0 +1,41 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"time"
+
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
+
+	pprutil "github.com/kubewharf/podseidon/util/podprotector"
+)
+
+func (api Api) WaitForAggregation(
+	ctx context.Context,
+	pprRef pprutil.PodProtectorKey,
+	observed *podseidonv1a1.PodProtector,
+	deadline time.Time,
+) (bool, *podseidonv1a1.PodProtector, error) {
+	return api.waitForAggregation(ctx, pprRef, observed, deadline)
+}
+
+// Number of PodProtectors with registered long-poll waiters.
+func (api Api) PprWaiterCount() int {
+	api.state.pprWaiters.mu.Lock()
+	defer api.state.pprWaiters.mu.Unlock()
+
+	return len(api.state.pprWaiters.waiters)
+}
@@ -0,0 +1,164 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
+
+	"github.com/kubewharf/podseidon/util/errors"
+	pprutil "github.com/kubewharf/podseidon/util/podprotector"
+	"github.com/kubewharf/podseidon/util/retrybatch"
+	"github.com/kubewharf/podseidon/util/util"
+
+	"github.com/kubewharf/podseidon/webhook/observer"
+)
+
+type GroupBatchArg = observer.GroupBatchArg
+
+// Reserves admissions in PodProtectorGroups,
+// analogous to PoolAdapter for the member PodProtectors.
+type GroupPoolAdapter struct {
+	sourceProvider pprutil.SourceProvider
+	pprInformer    pprutil.IndexedInformer
+	clock          clock.Clock
+	retryBackoff   func() time.Duration
+}
+
+func (GroupPoolAdapter) PoolName() string {
+	return "podprotectorgroup-update"
+}
+
+func (adapter GroupPoolAdapter) Execute(
+	ctx context.Context,
+	key pprutil.GroupKey,
+	args []GroupBatchArg,
+) retrybatch.ExecuteResult[pprutil.DisruptionResult] {
+	groupOptional, err := adapter.pprInformer.GetGroup(key)
+	if err != nil {
+		return retrybatch.ExecuteResultErr[pprutil.DisruptionResult](
+			errors.TagWrapf("GetListerGroup", err, "get group from lister"),
+		)
+	}
+
+	originalGroup, present := groupOptional.Get()
+	if !present {
+		// The group label refers to a nonexistent group, so only the member requirements apply.
+		return retrybatch.ExecuteResultSuccess(
+			func(int) pprutil.DisruptionResult { return pprutil.DisruptionResultOk },
+		)
+	}
+
+	members, err := adapter.pprInformer.ListGroupMembers(key)
+	if err != nil {
+		return retrybatch.ExecuteResultErr[pprutil.DisruptionResult](
+			errors.TagWrapf("ListGroupMembers", err, "list group members from lister"),
+		)
+	}
+
+	group := originalGroup.DeepCopy()
+	results := reserveInGroup(group, members, args, adapter.clock.Now())
+
+	if !equality.Semantic.DeepEqual(originalGroup, group) {
+		if err := adapter.sourceProvider.UpdateGroupStatus(ctx, key.SourceName, group); err != nil {
+			if apierrors.IsConflict(err) {
+				return retrybatch.ExecuteResultNeedRetry[pprutil.DisruptionResult](
+					adapter.retryBackoff(),
+				)
+			}
+
+			return retrybatch.ExecuteResultErr[pprutil.DisruptionResult](errors.TagWrapf(
+				"BatchUpdateGroupStatus",
+				err,
+				"unable to update PodProtectorGroup status",
+			))
+		}
+	}
+
+	return retrybatch.ExecuteResultSuccess(
+		func(i int) pprutil.DisruptionResult { return results[i] },
+	)
+}
+
+// Applies a batch of group reservations and rollbacks to the group status in place.
+func reserveInGroup(
+	group *podseidonv1a1.PodProtectorGroup,
+	members []*podseidonv1a1.PodProtector,
+	args []GroupBatchArg,
+	executeTime time.Time,
+) []pprutil.DisruptionResult {
+	results := make([]pprutil.DisruptionResult, len(args))
+
+	pprutil.PruneGroupAdmissions(group, members)
+
+	// Rollbacks are applied before computing the quota,
+	// consistent with PoolAdapter.
+	for argIndex, arg := range args {
+		if !arg.Rollback {
+			continue
+		}
+
+		results[argIndex] = pprutil.DisruptionResultOk
+
+		util.DrainSliceOrdered(&group.Status.Admissions, func(admission podseidonv1a1.PodProtectorGroupAdmission) bool {
+			return admission.PodUid != arg.PodUid
+		})
+	}
+
+	quota := pprutil.ComputeGroupDisruptionQuota(group, members)
+
+	for argIndex, arg := range args {
+		if arg.Rollback {
+			continue
+		}
+
+		if duplicate := findGroupAdmission(group, arg.PodUid); duplicate != -1 {
+			group.Status.Admissions[duplicate].StartTime = metav1.MicroTime{Time: executeTime}
+			results[argIndex] = pprutil.DisruptionResultOk // already disrupted
+
+			continue
+		}
+
+		result := quota.Disrupt()
+		results[argIndex] = result
+
+		if result == pprutil.DisruptionResultOk {
+			group.Status.Admissions = append(group.Status.Admissions, podseidonv1a1.PodProtectorGroupAdmission{
+				PodProtector: arg.PprName,
+				CellId:       arg.CellId,
+				PodUid:       arg.PodUid,
+				PodName:      arg.PodName,
+				StartTime:    metav1.MicroTime{Time: executeTime},
+			})
+		}
+	}
+
+	return results
+}
+
+func findGroupAdmission(group *podseidonv1a1.PodProtectorGroup, podUid types.UID) int {
+	return util.FindInSliceWith(
+		group.Status.Admissions,
+		func(admission podseidonv1a1.PodProtectorGroupAdmission) bool { return admission.PodUid == podUid },
+	)
+}
@@ -18,23 +18,31 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"math"
 	"math/rand"
 	"net/http"
+	"strconv"
 	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	authenticationv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 
 	podseidon "github.com/kubewharf/podseidon/apis"
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
 
 	"github.com/kubewharf/podseidon/util/component"
 	"github.com/kubewharf/podseidon/util/defaultconfig"
 	"github.com/kubewharf/podseidon/util/errors"
+	utilflag "github.com/kubewharf/podseidon/util/flag"
 	"github.com/kubewharf/podseidon/util/kube"
 	"github.com/kubewharf/podseidon/util/o11y"
 	"github.com/kubewharf/podseidon/util/optional"
@@ -48,6 +56,12 @@ import (
 
 const batchGoroutineIdleTimeout = time.Second
 
+// Upper bound on the time spent rolling back the reservations of a rejected request.
+//
+// Rollbacks are detached from the request context,
+// since the request deadline is usually the very reason for the rollback.
+const rollbackTimeout = time.Second * 5
+
 var New = component.Declare(
 	func(_ Args) string { return "webhook-handler" },
 	func(_ Args, fs *flag.FlagSet) Options {
@@ -67,6 +81,29 @@ var New = component.Declare(
 				time.Millisecond*100,
 				"the actual retry backoff is uniformly distributed between [base, base+jitter)",
 			),
+			LongPoll: fs.Bool(
+				"long-poll",
+				false,
+				"wait for newer PodProtector aggregation within the request instead of advising the client to retry immediately",
+			),
+			LongPollMargin: fs.Duration(
+				"long-poll-margin",
+				time.Second,
+				"time reserved before the request deadline for re-running the quota check after long-polling",
+			),
+			DeadlineVerdict: deadlineVerdictFlag(fs),
+			LeaseSize: utilflag.Int32(
+				fs,
+				"lease-size",
+				0,
+				"maximum number of deletions reserved by each quota lease; 0 disables quota leasing",
+			),
+			LeaseDuration: fs.Duration(
+				"lease-duration",
+				time.Second*10,
+				"time after which an unreturned quota lease no longer reserves quota; "+
+					"deletions are only admitted against a lease in the first half of this duration",
+			),
 		}
 	},
 	func(_ Args, requests *component.DepRequests) Deps {
@@ -81,6 +118,9 @@ var New = component.Declare(
 			),
 			observer:        o11y.Request[observer.Observer](requests),
 			requiresPodName: component.DepPtr(requests, RequestRequiresPodName()),
+			deleterIdentity: component.DepPtr(requests, RequestDeleterIdentity()),
+			podGetter:       component.DepPtr(requests, RequestPodGetter()),
+			exemption:       component.DepPtr(requests, NewExemption(util.Empty{})),
 			retrybatchObs:   o11y.Request[retrybatchobserver.Observer](requests),
 			defaultConfig:   component.DepPtr(requests, defaultconfig.New(util.Empty{})),
 		}
@@ -89,6 +129,28 @@ var New = component.Declare(
 		sourceProvider := deps.sourceProvider.Get()
 
 		poolReader, poolWriter := util.NewLateInit[retrybatch.Pool[pprutil.PodProtectorKey, BatchArg, pprutil.DisruptionResult]]()
+		groupPoolReader, groupPoolWriter := util.NewLateInit[retrybatch.Pool[pprutil.GroupKey, GroupBatchArg, pprutil.DisruptionResult]]()
+
+		pprWaiters := newPprWaiters()
+		deps.pprInformer.Get().AddPostHandler(pprWaiters.notify)
+
+		leases, err := newLeaseStore(
+			args.Clock,
+			deps.observer.Get(),
+			poolReader,
+			*options.LeaseSize,
+			*options.LeaseDuration,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		retryBackoff := func() time.Duration {
+			return jitterDuration(
+				*options.RetryBackoffBase,
+				*options.RetryBackoffBase+*options.RetryJitter,
+			)
+		}
 
 		return &State{
 			sourceProvider:    sourceProvider,
@@ -101,27 +163,45 @@ var New = component.Declare(
 					observer:        deps.observer.Get(),
 					clock:           args.Clock,
 					requiresPodName: deps.requiresPodName.Get(),
-					retryBackoff: func() time.Duration {
-						return jitterDuration(
-							*options.RetryBackoffBase,
-							*options.RetryBackoffBase+*options.RetryJitter,
-						)
-					},
-					defaultConfig: deps.defaultConfig.Get(),
+					deleterIdentity: deps.deleterIdentity.Get(),
+					retryBackoff:    retryBackoff,
+					defaultConfig:   deps.defaultConfig.Get(),
+					leases:          leases,
 				},
 				*options.ColdStartDelay, batchGoroutineIdleTimeout,
 			),
 			poolWriter: poolWriter,
 			poolReader: poolReader,
+			groupPoolConfig: retrybatch.NewPool(
+				deps.retrybatchObs.Get(),
+				GroupPoolAdapter{
+					sourceProvider: sourceProvider,
+					pprInformer:    deps.pprInformer.Get(),
+					clock:          args.Clock,
+					retryBackoff:   retryBackoff,
+				},
+				*options.ColdStartDelay, batchGoroutineIdleTimeout,
+			),
+			groupPoolWriter: groupPoolWriter,
+			groupPoolReader: groupPoolReader,
+			pprWaiters:      pprWaiters,
+			leases:          leases,
 		}, nil
 	},
 	component.Lifecycle[Args, Options, Deps, State]{
 		Start: func(ctx context.Context, _ *Args, _ *Options, _ *Deps, state *State) error {
+			state.leases.ctx = ctx
+
 			pool := state.poolConfig.Create(ctx)
 			pool.StartMonitor(ctx)
 
 			state.poolWriter(pool)
 
+			groupPool := state.groupPoolConfig.Create(ctx)
+			groupPool.StartMonitor(ctx)
+
+			state.groupPoolWriter(groupPool)
+
 			return nil
 		},
 		Join:         nil,
@@ -129,10 +209,17 @@ var New = component.Declare(
 	},
 	func(d *component.Data[Args, Options, Deps, State]) Api {
 		return Api{
-			state:       d.State,
-			clk:         d.Args.Clock,
-			observer:    d.Deps.observer.Get(),
-			pprInformer: d.Deps.pprInformer.Get(),
+			state:         d.State,
+			clk:           d.Args.Clock,
+			observer:      d.Deps.observer.Get(),
+			pprInformer:   d.Deps.pprInformer.Get(),
+			podGetter:     d.Deps.podGetter.Get(),
+			exemption:     d.Deps.exemption.Get(),
+			defaultConfig: d.Deps.defaultConfig.Get(),
+
+			longPoll:        *d.Options.LongPoll,
+			longPollMargin:  *d.Options.LongPollMargin,
+			deadlineVerdict: *d.Options.DeadlineVerdict,
 		}
 	},
 )
@@ -145,6 +232,11 @@ type Options struct {
 	ColdStartDelay   *time.Duration
 	RetryBackoffBase *time.Duration
 	RetryJitter      *time.Duration
+	LongPoll         *bool
+	LongPollMargin   *time.Duration
+	DeadlineVerdict  *DeadlineVerdict
+	LeaseSize        *int32
+	LeaseDuration    *time.Duration
 }
 
 type Deps struct {
@@ -152,6 +244,9 @@ type Deps struct {
 	pprInformer     component.Dep[pprutil.IndexedInformer]
 	observer        component.Dep[observer.Observer]
 	requiresPodName component.Dep[RequiresPodName]
+	deleterIdentity component.Dep[DeleterIdentity]
+	podGetter       component.Dep[PodGetter]
+	exemption       component.Dep[*ExemptionState]
 	retrybatchObs   component.Dep[retrybatchobserver.Observer]
 	defaultConfig   component.Dep[*defaultconfig.Options]
 }
@@ -163,6 +258,13 @@ type State struct {
 	poolConfig retrybatch.PoolConfig[pprutil.PodProtectorKey, BatchArg, pprutil.DisruptionResult]
 	poolWriter util.LateInitWriter[retrybatch.Pool[pprutil.PodProtectorKey, BatchArg, pprutil.DisruptionResult]]
 	poolReader util.LateInitReader[retrybatch.Pool[pprutil.PodProtectorKey, BatchArg, pprutil.DisruptionResult]]
+
+	groupPoolConfig retrybatch.PoolConfig[pprutil.GroupKey, GroupBatchArg, pprutil.DisruptionResult]
+	groupPoolWriter util.LateInitWriter[retrybatch.Pool[pprutil.GroupKey, GroupBatchArg, pprutil.DisruptionResult]]
+	groupPoolReader util.LateInitReader[retrybatch.Pool[pprutil.GroupKey, GroupBatchArg, pprutil.DisruptionResult]]
+
+	pprWaiters *pprWaiters
+	leases     *leaseStore
 }
 
 type Api struct {
@@ -170,12 +272,22 @@ type Api struct {
 	clk         clock.Clock
 	observer    observer.Observer
 	pprInformer pprutil.IndexedInformer
+	podGetter   PodGetter
+	exemption   *ExemptionState
+
+	defaultConfig *defaultconfig.Options
+
+	longPoll        bool
+	longPollMargin  time.Duration
+	deadlineVerdict DeadlineVerdict
 }
 
 type HandleResult struct {
 	Status    observer.RequestStatus
 	Rejection optional.Optional[Rejection]
 	Err       error
+	// Warnings to display to the requester, e.g. rejections that are not enforced in a DryRun window.
+	Warnings []string
 }
 
 func errHandleResult(err error) (HandleResult, bool) {
@@ -183,51 +295,122 @@ func errHandleResult(err error) (HandleResult, bool) {
 		Status:    observer.RequestStatusError,
 		Rejection: optional.None[Rejection](),
 		Err:       err,
+		Warnings:  nil,
 	}, false
 }
 
+// If ctx has a deadline, quota reservation is abandoned before the deadline
+// and the request is responded with the configured DeadlineVerdict.
+//
+// `resolvedSubject` is the pod under review if it was already resolved by RoutingKey,
+// so that it is not fetched again, or nil otherwise.
+//
 //nolint:cyclop // Mostly just top-level error branches. Further abstraction does not improve readability.
 func (api Api) Handle(
 	ctx context.Context,
 	req *admissionv1.AdmissionRequest,
 	cellId string,
 	auditAnnotations map[string]string,
+	resolvedSubject *corev1.Pod,
 ) (_ HandleResult, _preferDryRun bool) {
-	if !isRelevantRequest(req) {
+	longPollDeadline := optional.None[time.Time]()
+	if deadline, hasDeadline := ctx.Deadline(); hasDeadline && api.longPoll {
+		longPollDeadline = optional.Some(deadline.Add(-api.longPollMargin))
+	}
+
+	kind := classifyRequest(req)
+	if kind == reviewKindNotRelevant {
 		return HandleResult{
 			Status: observer.RequestStatusNotRelevant,
 			Rejection: optional.Some(Rejection{
-				Code:    http.StatusInternalServerError,
-				Message: "Unexpected review subject; only pod deletions are handled by this webhook",
+				Code:              http.StatusInternalServerError,
+				Message:           "Unexpected review subject; only pod deletions and evictions are handled by this webhook",
+				Reason:            podseidon.RejectionReasonNotRelevant,
+				Subject:           optional.None[RejectionSubject](),
+				Quota:             optional.None[RejectionQuota](),
+				RetryAfterSeconds: 0,
 			}),
-			Err: nil,
+			Err:      nil,
+			Warnings: nil,
 		}, false
 	}
 
-	podJson := req.OldObject.Raw
-	if len(podJson) == 0 {
-		return errHandleResult(errors.TagErrorf(
-			"EmptyOldObject",
-			"oldObject is missing in delete review request",
-		))
+	deleteOptions, err := decodeDeleteOptions(req, kind)
+	if err != nil {
+		return errHandleResult(err)
 	}
 
-	var subject *corev1.Pod
-	if err := json.Unmarshal(podJson, &subject); err != nil {
-		return errHandleResult(errors.TagErrorf(
-			"OldObjectJsonError",
-			"cannot unmarshal oldObject as a *corev1.Pod",
-		))
+	subject := resolvedSubject
+	if subject == nil {
+		subject, err = api.getSubject(ctx, req, cellId)
+	}
+
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			// The request would fail with 404 anyway, e.g. when a drained pod was deleted concurrently.
+			return HandleResult{
+				Status:    observer.RequestStatusPodNotFound,
+				Rejection: optional.None[Rejection](),
+				Err:       nil,
+				Warnings:  nil,
+			}, false
+		}
+
+		return errHandleResult(err)
 	}
 
 	_, preferDryRun := subject.Annotations[podseidon.PodAnnotationForceDelete]
 
+	exemptionRule := ""
+
+	if rule, matched := api.exemption.Match(req.UserInfo, subject).Get(); matched {
+		exemptionRule = rule.Name
+		auditAnnotations[podseidon.AuditAnnotationExemptionRule] = rule.Name
+
+		switch rule.Action {
+		case ExemptionActionAlwaysAllow:
+			api.observer.ExemptPod(ctx, observer.ExemptPod{
+				Namespace:        subject.Namespace,
+				PodName:          subject.Name,
+				PodCell:          cellId,
+				DeleteUserName:   req.UserInfo.Username,
+				DeleteUserGroups: req.UserInfo.Groups,
+				ExemptionRule:    rule.Name,
+			})
+
+			return HandleResult{
+				Status:    observer.RequestStatusExempted,
+				Rejection: optional.None[Rejection](),
+				Err:       nil,
+				Warnings:  nil,
+			}, preferDryRun
+		case ExemptionActionDryRun:
+			preferDryRun = true
+		case ExemptionActionEnforce:
+		}
+	}
+
+	if !preconditionsMatch(deleteOptions.Preconditions, subject) {
+		// apiserver rejects the deletion with 409 anyway, so the request must not consume any quota.
+		return HandleResult{
+			Status:    observer.RequestStatusPreconditionMismatch,
+			Rejection: optional.None[Rejection](),
+			Err:       nil,
+			Warnings:  nil,
+		}, preferDryRun
+	}
+
+	// Dry-run requests must not have side effects,
+	// so they are evaluated against the informer copy without reserving quota.
+	dryRun := ptr.Deref(req.DryRun, false) || len(deleteOptions.DryRun) > 0
+
 	if !subject.DeletionTimestamp.IsZero() {
 		// Pods that are already terminating should not contribute twice to the admission history.
 		return HandleResult{
 			Status:    observer.RequestStatusAlreadyTerminating,
 			Rejection: optional.None[Rejection](),
 			Err:       nil,
+			Warnings:  nil,
 		}, preferDryRun
 	}
 
@@ -253,6 +436,7 @@ func (api Api) Handle(
 			Status:    observer.RequestStatusAlreadyUnready,
 			Rejection: optional.None[Rejection](),
 			Err:       nil,
+			Warnings:  nil,
 		}, preferDryRun
 	}
 
@@ -263,31 +447,93 @@ func (api Api) Handle(
 	}
 
 	admitted := 0
+	reserved := []pprutil.PodProtectorKey{}
+	warnings := []string(nil)
+
+	// The first admitting member of each group, through which the pod is reserved in the group.
+	groupMembers := map[pprutil.GroupKey]string{}
+	groupOrder := []pprutil.GroupKey{}
 
 	for _, pprRef := range api.pprInformer.Query(subject.Namespace, subject.Labels) {
-		// If multiple PodProtector are matched, short circuit when any of them fails.
-		// Ideally we should roll back previous PodProtectors,
-		// but it is currently unimplemented because
-		// there are no pods matching multiple PodProtectors in practice.
-		result, canContinue := api.handlePodInPpr(ctx, pprRef, subject, podReadyTime, req.UserInfo, cellId)
+		// If multiple PodProtector are matched, short circuit when any of them fails,
+		// and roll back the reservations in previously admitted PodProtectors
+		// so that they do not undercount the available pods until aggregator catches up.
+		result, canContinue := api.handlePodInPpr(
+			ctx,
+			pprRef,
+			subject,
+			podReadyTime,
+			req.UserInfo,
+			exemptionRule,
+			cellId,
+			kind,
+			dryRun,
+			longPollDeadline,
+		)
+
+		warnings = append(warnings, result.Warnings...)
 
 		if !canContinue {
 			auditAnnotations[podseidon.AuditAnnotationRejectByPpr] = pprRef.Name
 
+			api.rollbackReservations(ctx, reserved, subject, cellId)
+
+			result.Warnings = warnings
+
 			return result, preferDryRun
 		}
 
 		admitted++
+
+		if result.Status == observer.RequestStatusAdmittedAll {
+			reserved = append(reserved, pprRef)
+		}
+
+		if result.Status == observer.RequestStatusAdmittedAll || dryRun && result.Status == observer.RequestStatusDryRun {
+			if groupRef, isMember := api.groupOf(pprRef).Get(); isMember {
+				if _, seen := groupMembers[groupRef]; !seen {
+					groupMembers[groupRef] = pprRef.Name
+					groupOrder = append(groupOrder, groupRef)
+				}
+			}
+		}
+	}
+
+	reservedGroups := []pprutil.GroupKey{}
+
+	for _, groupRef := range groupOrder {
+		// Groups are only evaluated after all matched members have admitted the pod,
+		// so that a pod rejected by a member does not consume the group quota.
+		result, canContinue := api.handlePodInGroup(ctx, groupRef, groupMembers[groupRef], subject, cellId, kind, dryRun)
+
+		if !canContinue {
+			auditAnnotations[podseidon.AuditAnnotationRejectByGroup] = groupRef.Name
+
+			api.rollbackReservations(ctx, reserved, subject, cellId)
+			api.rollbackGroupReservations(ctx, reservedGroups, subject, cellId)
+
+			warnings = append(warnings, result.Warnings...)
+			result.Warnings = warnings
+
+			return result, preferDryRun
+		}
+
+		if !dryRun {
+			reservedGroups = append(reservedGroups, groupRef)
+		}
 	}
 
 	result := HandleResult{
 		Status:    observer.RequestStatusAdmittedAll,
 		Rejection: optional.None[Rejection](),
 		Err:       nil,
+		Warnings:  warnings,
 	}
 
 	if admitted == 0 {
 		result.Status = observer.RequestStatusUnmatched
+	} else if dryRun {
+		result.Status = observer.RequestStatusDryRun
 	}
 
 	return result, preferDryRun
@@ -299,7 +545,11 @@ func (api Api) handlePodInPpr(
 	pod *corev1.Pod,
 	podReadyTime time.Duration,
 	user authenticationv1.UserInfo,
+	exemptionRule string,
 	cellId string,
+	kind reviewKind,
+	dryRun bool,
+	longPollDeadline optional.Optional[time.Time],
 ) (_ HandleResult, _canContinue bool) {
 	ctx, cancelFunc := api.observer.StartHandlePodInPpr(ctx, observer.StartHandlePodInPpr{
 		Namespace: pod.Namespace,
@@ -309,10 +559,12 @@ func (api Api) handlePodInPpr(
 
 		DeleteUserName:   user.Username,
 		DeleteUserGroups: user.Groups,
+
+		ExemptionRule: exemptionRule,
 	})
 	defer cancelFunc()
 
-	result := api.determineRejection(ctx, pprRef, podReadyTime, pod, cellId)
+	result := api.determineRejection(ctx, pprRef, podReadyTime, pod, user, cellId, kind, dryRun, longPollDeadline)
 
 	// code is only used for o11y.
 	{
@@ -331,15 +583,324 @@ func (api Api) handlePodInPpr(
 	return result, result.Err == nil && !result.Rejection.IsSome()
 }
 
-func isRelevantRequest(req *admissionv1.AdmissionRequest) bool {
-	return req != nil &&
-		req.Operation == admissionv1.Delete && // TODO do we also handle CREATE /eviction?
-		req.Resource == metav1.GroupVersionResource{
-			Group:    corev1.SchemeGroupVersion.Group,
-			Version:  corev1.SchemeGroupVersion.Version, // we required matchPolicy=Equivalent
-			Resource: "pods",
-		} &&
-		req.SubResource == ""
+// Removes the admission bucket of the pod from each of the PodProtectors.
+//
+// Rollback failures are only reported to the observer,
+// since the leaked bucket is eventually cleared by aggregator anyway.
+func (api Api) rollbackReservations(
+	ctx context.Context,
+	pprRefs []pprutil.PodProtectorKey,
+	pod *corev1.Pod,
+	cellId string,
+) {
+	api.detachRollback(ctx, func(rollbackCtx context.Context) {
+		for _, pprRef := range pprRefs {
+			var err error
+
+			// Deletions admitted against a lease held locally have not been written yet.
+			if !api.state.leases.rollback(pprRef, pod.UID) {
+				_, err = api.state.poolReader.Get().Submit(rollbackCtx, pprRef, BatchArg{
+					CellId:       cellId,
+					PodUid:       pod.UID,
+					PodName:      pod.Name,
+					Username:     "",
+					Operation:    "",
+					Rollback:     true,
+					AcquireLease: false,
+					ReturnLease:  optional.None[observer.LeaseReturn](),
+				})
+			}
+
+			api.observer.RollbackReservation(rollbackCtx, observer.RollbackReservation{
+				Namespace: pprRef.Namespace,
+				PprName:   pprRef.Name,
+				PodName:   pod.Name,
+				PodCell:   cellId,
+				Err:       err,
+			})
+		}
+	})
+}
+
+// Removes the admission of the pod from each of the PodProtectorGroups.
+//
+// Similar to rollbackReservations, a leaked admission is eventually pruned
+// when aggregator observes the pod.
+func (api Api) rollbackGroupReservations(
+	ctx context.Context,
+	groupRefs []pprutil.GroupKey,
+	pod *corev1.Pod,
+	cellId string,
+) {
+	api.detachRollback(ctx, func(rollbackCtx context.Context) {
+		for _, groupRef := range groupRefs {
+			_, err := api.state.groupPoolReader.Get().Submit(rollbackCtx, groupRef, GroupBatchArg{
+				PprName:  "",
+				CellId:   cellId,
+				PodUid:   pod.UID,
+				PodName:  pod.Name,
+				Rollback: true,
+			})
+
+			api.observer.RollbackReservation(rollbackCtx, observer.RollbackReservation{
+				Namespace: groupRef.Namespace,
+				PprName:   "",
+				GroupName: groupRef.Name,
+				PodName:   pod.Name,
+				PodCell:   cellId,
+				Err:       err,
+			})
+		}
+	})
+}
+
+// Runs a rollback under a context that survives the cancellation of the request context,
+// bounded by rollbackTimeout instead.
+//
+// If the request context has already ended, the response is already late,
+// so the rollback continues in the background instead of delaying the response further.
+func (api Api) detachRollback(ctx context.Context, rollback func(rollbackCtx context.Context)) {
+	rollbackCtx, cancelFunc := context.WithTimeout(context.WithoutCancel(ctx), rollbackTimeout)
+
+	if ctx.Err() != nil {
+		go func() {
+			defer cancelFunc()
+			rollback(rollbackCtx)
+		}()
+
+		return
+	}
+
+	defer cancelFunc()
+	rollback(rollbackCtx)
+}
+
+// Returns the group that the PodProtector is a member of, if any.
+func (api Api) groupOf(pprRef pprutil.PodProtectorKey) optional.Optional[pprutil.GroupKey] {
+	pprOptional, err := api.pprInformer.Get(pprRef)
+	if err != nil {
+		return optional.None[pprutil.GroupKey]()
+	}
+
+	ppr, present := pprOptional.Get()
+	if !present {
+		return optional.None[pprutil.GroupKey]()
+	}
+
+	return pprutil.GroupOf(pprRef, ppr)
+}
+
+func (api Api) handlePodInGroup(
+	ctx context.Context,
+	groupRef pprutil.GroupKey,
+	pprName string,
+	pod *corev1.Pod,
+	cellId string,
+	kind reviewKind,
+	dryRun bool,
+) (_ HandleResult, _canContinue bool) {
+	result := api.determineGroupRejection(ctx, groupRef, pprName, pod, cellId, kind, dryRun)
+
+	api.observer.HandlePodInGroup(ctx, observer.HandlePodInGroup{
+		Namespace: groupRef.Namespace,
+		GroupName: groupRef.Name,
+		PprName:   pprName,
+		PodName:   pod.Name,
+		PodCell:   cellId,
+		Rejected:  result.Rejection.IsSome(),
+		Err:       result.Err,
+	})
+
+	return result, result.Err == nil && !result.Rejection.IsSome()
+}
+
+func (api Api) determineGroupRejection(
+	ctx context.Context,
+	groupRef pprutil.GroupKey,
+	pprName string,
+	pod *corev1.Pod,
+	cellId string,
+	kind reviewKind,
+	dryRun bool,
+) HandleResult {
+	if dryRun {
+		result, err := api.evaluateGroupReadOnly(groupRef, pod.UID)
+		if err != nil {
+			return HandleResult{
+				Status: observer.RequestStatusError,
+				Rejection: optional.Some(internalErrorRejection(
+					fmt.Sprintf("Cannot fetch group from informer store: %s", err.Error()),
+				)),
+				Err:      errors.TagWrapf("GetGroupFromInformer", err, "cannot fetch PodProtectorGroup from informer store"),
+				Warnings: nil,
+			}
+		}
+
+		return toHandleResult(groupRejectionSubject(groupRef), result, kind, dryRun, api.groupRejectionHints(groupRef))
+	}
+
+	result, err := api.state.groupPoolReader.Get().Submit(ctx, groupRef, GroupBatchArg{
+		PprName:  pprName,
+		CellId:   cellId,
+		PodUid:   pod.UID,
+		PodName:  pod.Name,
+		Rollback: false,
+	})
+	if err != nil && isDeadlineExceeded(err) {
+		return api.deadlineExceededResult(ctx, groupRejectionSubject(groupRef), pod, cellId, kind, err)
+	}
+
+	if err != nil {
+		return HandleResult{
+			Status: observer.RequestStatusError,
+			Rejection: optional.Some(internalErrorRejection(
+				fmt.Sprintf("Cannot reserve PodProtectorGroup admission: %s", err.Error()),
+			)),
+			Err:      errors.TagWrapf("ReserveGroupAdmission", err, "cannot reserve PodProtectorGroup admission"),
+			Warnings: nil,
+		}
+	}
+
+	return toHandleResult(groupRejectionSubject(groupRef), result, kind, dryRun, api.groupRejectionHints(groupRef))
+}
+
+// Computes the disruption result that GroupPoolAdapter would return for a single pod
+// without modifying the PodProtectorGroup.
+func (api Api) evaluateGroupReadOnly(groupRef pprutil.GroupKey, podUid types.UID) (pprutil.DisruptionResult, error) {
+	groupOptional, err := api.pprInformer.GetGroup(groupRef)
+	if err != nil {
+		return pprutil.DisruptionResultOk, errors.TagWrapf("GetListerGroup", err, "get group from lister")
+	}
+
+	originalGroup, present := groupOptional.Get()
+	if !present {
+		return pprutil.DisruptionResultOk, nil
+	}
+
+	members, err := api.pprInformer.ListGroupMembers(groupRef)
+	if err != nil {
+		return pprutil.DisruptionResultOk, errors.TagWrapf("ListGroupMembers", err, "list group members from lister")
+	}
+
+	results := reserveInGroup(
+		originalGroup.DeepCopy(),
+		members,
+		//nolint:exhaustruct // only the pod UID is relevant for evaluation
+		[]GroupBatchArg{{PodUid: podUid}},
+		api.clk.Now(),
+	)
+
+	return results[0], nil
+}
+
+type reviewKind uint8
+
+const (
+	reviewKindNotRelevant reviewKind = iota
+	// DELETE on pods.
+	reviewKindDelete
+	// CREATE on pods/eviction.
+	//
+	// The eviction subresource deletes the pod internally without calling admission webhooks for pod DELETE,
+	// so evictions must be reviewed separately.
+	reviewKindEviction
+)
+
+func (kind reviewKind) admissionOperation() podseidonv1a1.PodProtectorAdmissionOperation {
+	if kind == reviewKindEviction {
+		return podseidonv1a1.PodProtectorAdmissionOperationEvict
+	}
+
+	return podseidonv1a1.PodProtectorAdmissionOperationDelete
+}
+
+func classifyRequest(req *admissionv1.AdmissionRequest) reviewKind {
+	if req == nil || req.Resource != (metav1.GroupVersionResource{
+		Group:    corev1.SchemeGroupVersion.Group,
+		Version:  corev1.SchemeGroupVersion.Version, // we required matchPolicy=Equivalent
+		Resource: "pods",
+	}) {
+		return reviewKindNotRelevant
+	}
+
+	switch {
+	case req.Operation == admissionv1.Delete && req.SubResource == "":
+		return reviewKindDelete
+	case req.Operation == admissionv1.Create && req.SubResource == "eviction":
+		return reviewKindEviction
+	default:
+		return reviewKindNotRelevant
+	}
+}
+
+// Decodes the DeleteOptions that apiserver would apply when deleting the pod.
+func decodeDeleteOptions(req *admissionv1.AdmissionRequest, kind reviewKind) (_zero metav1.DeleteOptions, _ error) {
+	switch kind {
+	case reviewKindEviction:
+		var eviction policyv1.Eviction
+		if err := json.Unmarshal(req.Object.Raw, &eviction); err != nil {
+			return _zero, errors.TagWrapf("EvictionJsonError", err, "cannot unmarshal object as a *policyv1.Eviction")
+		}
+
+		if eviction.DeleteOptions == nil {
+			return _zero, nil
+		}
+
+		return *eviction.DeleteOptions, nil
+	default:
+		if len(req.Options.Raw) == 0 {
+			return _zero, nil
+		}
+
+		var options metav1.DeleteOptions
+		if err := json.Unmarshal(req.Options.Raw, &options); err != nil {
+			return _zero, errors.TagWrapf("OptionsJsonError", err, "cannot unmarshal options as a *metav1.DeleteOptions")
+		}
+
+		return options, nil
+	}
+}
+
+// Returns the pod under review, fetching it from the cell if the review does not include it.
+func (api Api) getSubject(ctx context.Context, req *admissionv1.AdmissionRequest, cellId string) (*corev1.Pod, error) {
+	if podJson := req.OldObject.Raw; len(podJson) > 0 {
+		var subject *corev1.Pod
+		if err := json.Unmarshal(podJson, &subject); err != nil {
+			return nil, errors.TagErrorf(
+				"OldObjectJsonError",
+				"cannot unmarshal oldObject as a *corev1.Pod",
+			)
+		}
+
+		return subject, nil
+	}
+
+	subject, err := api.podGetter.GetPod(ctx, PodGetterArg{
+		CellId:    cellId,
+		Namespace: req.Namespace,
+		Name:      req.Name,
+	})
+	if err != nil {
+		return nil, errors.TagWrapf("FetchPod", err, "oldObject is absent and the pod cannot be fetched")
+	}
+
+	return subject, nil
+}
+
+func preconditionsMatch(preconditions *metav1.Preconditions, pod *corev1.Pod) bool {
+	if preconditions == nil {
+		return true
+	}
+
+	if preconditions.UID != nil && *preconditions.UID != pod.UID {
+		return false
+	}
+
+	if preconditions.ResourceVersion != nil && *preconditions.ResourceVersion != pod.ResourceVersion {
+		return false
+	}
+
+	return true
 }
 
 func (api Api) determineRejection(
@@ -347,17 +908,21 @@ func (api Api) determineRejection(
 	pprRef pprutil.PodProtectorKey,
 	podReadyTime time.Duration,
 	pod *corev1.Pod,
+	user authenticationv1.UserInfo,
 	cellId string,
+	kind reviewKind,
+	dryRun bool,
+	longPollDeadline optional.Optional[time.Time],
 ) HandleResult {
 	ppr, err := api.pprInformer.Get(pprRef)
 	if err != nil || ppr.IsNone() {
 		return HandleResult{
 			Status: observer.RequestStatusError,
-			Rejection: optional.Some(Rejection{
-				Code:    http.StatusInternalServerError,
-				Message: fmt.Sprintf("Cannot fetch ppr from informer store: %s", err.Error()),
-			}),
-			Err: errors.TagWrapf("GetPprFromInformer", err, "cannot fetch PodProtector from informer store"),
+			Rejection: optional.Some(internalErrorRejection(
+				fmt.Sprintf("Cannot fetch ppr from informer store: %s", err.Error()),
+			)),
+			Err:      errors.TagWrapf("GetPprFromInformer", err, "cannot fetch PodProtector from informer store"),
+			Warnings: nil,
 		}
 	}
 
@@ -365,74 +930,507 @@ func (api Api) determineRejection(
 	// There is a possible race condition where the pod becomes available just after this request gets admitted
 	// e.g. due to clock skew or network latency in replicaset/deployment controller allowing the next pod to roll,
 	// but this marginal case is exceptionally rare and is impractical to prevent.
-	minReadySeconds := ppr.MustGet("checked !ppr.IsNone()").Spec.MinReadySeconds
+	pprObj := ppr.MustGet("checked !ppr.IsNone()")
+
+	minReadySeconds := pprObj.Spec.MinReadySeconds
 	if podReadyTime < time.Duration(minReadySeconds)*time.Second {
 		return HandleResult{
 			Status:    observer.RequestStatusStillUnavailable,
 			Rejection: optional.None[Rejection](),
 			Err:       nil,
+			Warnings:  nil,
+		}
+	}
+
+	if window, inWindow := pprutil.ActiveWindow(pprObj.Spec, api.clk.Now()).Get(); inWindow {
+		//revive:disable-next-line:enforce-switch-style
+		switch window.Action {
+		case podseidonv1a1.PodProtectorWindowActionFreeze:
+			return HandleResult{
+				Status: observer.RequestStatusFrozen,
+				Rejection: optional.Some(Rejection{
+					Code: deniedCode(kind),
+					Message: fmt.Sprintf(
+						"%s does not admit pod deletion during window %q",
+						pprRef.DisplayName(), window.Name,
+					),
+					Reason:            podseidon.RejectionReasonFrozen,
+					Subject:           optional.Some(pprRejectionSubject(pprRef)),
+					Quota:             optional.None[RejectionQuota](),
+					RetryAfterSeconds: 0,
+				}),
+				Err:      nil,
+				Warnings: nil,
+			}
+		case podseidonv1a1.PodProtectorWindowActionDryRun:
+			config := api.defaultConfig.Compute(optional.Some(pprObj.Spec.AdmissionHistoryConfig))
+			result := evaluateReadOnly(config, pprObj, pod.UID, cellId, api.clk.Now())
+
+			// The result is only reported to the observer and as a warning, and never enforced.
+			handleResult := toHandleResult(pprRejectionSubject(pprRef), result, kind, true, noRejectionHints())
+			if rejection, rejected := handleResult.Rejection.Get(); rejected {
+				handleResult.Warnings = append(handleResult.Warnings, fmt.Sprintf(
+					"podseidon (dry-run window %q): pod deletion would be rejected: %s",
+					window.Name, rejection.Message,
+				))
+			}
+
+			handleResult.Rejection = optional.None[Rejection]()
+
+			return handleResult
+		case podseidonv1a1.PodProtectorWindowActionOverride:
+			// handled in quota computation
 		}
 	}
 
-	result, err := api.state.poolReader.Get().Submit(ctx, pprRef, BatchArg{CellId: cellId, PodUid: pod.UID, PodName: pod.Name})
+	if dryRun {
+		config := api.defaultConfig.Compute(optional.Some(pprObj.Spec.AdmissionHistoryConfig))
+		result := evaluateReadOnly(config, pprObj, pod.UID, cellId, api.clk.Now())
+
+		hints := pprRejectionHints(config, pprObj, api.clk.Now())
+
+		return toHandleResult(pprRejectionSubject(pprRef), result, kind, dryRun, hints)
+	}
+
+	batchArg := BatchArg{
+		CellId:       cellId,
+		PodUid:       pod.UID,
+		PodName:      pod.Name,
+		Username:     user.Username,
+		Operation:    kind.admissionOperation(),
+		Rollback:     false,
+		AcquireLease: false,
+		ReturnLease:  optional.None[observer.LeaseReturn](),
+	}
+
+	if api.state.leases.enabled() && leaseEligible(pprObj.Spec) {
+		admitted, held := api.state.leases.admit(pprRef, batchArg)
+		if admitted {
+			return toHandleResult(pprRejectionSubject(pprRef), pprutil.DisruptionResultOk, kind, dryRun, noRejectionHints())
+		}
+
+		batchArg.AcquireLease = !held
+	}
+
+	result, err := api.state.poolReader.Get().Submit(ctx, pprRef, batchArg)
+	if err != nil && isDeadlineExceeded(err) {
+		return api.deadlineExceededResult(ctx, pprRejectionSubject(pprRef), pod, cellId, kind, err)
+	}
+
 	if err != nil {
 		return HandleResult{
 			Status: observer.RequestStatusError,
-			Rejection: optional.Some(Rejection{
-				Code:    http.StatusInternalServerError,
-				Message: fmt.Sprintf("Cannot reserve PodProtector admission: %s", err.Error()),
-			}),
-			Err: errors.TagWrapf("ReserveAdmission", err, "cannot reserve PodProtector admission"),
+			Rejection: optional.Some(internalErrorRejection(
+				fmt.Sprintf("Cannot reserve PodProtector admission: %s", err.Error()),
+			)),
+			Err:      errors.TagWrapf("ReserveAdmission", err, "cannot reserve PodProtector admission"),
+			Warnings: nil,
+		}
+	}
+
+	config := api.defaultConfig.Compute(optional.Some(pprObj.Spec.AdmissionHistoryConfig))
+
+	hints := pprRejectionHints(config, pprObj, api.clk.Now())
+
+	// Newer aggregation cannot replenish an exhausted disruption rate, so only the admission buffer is long-polled.
+	if deadline, canLongPoll := longPollDeadline.Get(); canLongPoll &&
+		result == pprutil.DisruptionResultRetry && hints.rateRetryAfter.IsNone() {
+		result, pprObj, err = api.longPollRetry(ctx, pprRef, pprObj, deadline, batchArg)
+		if err != nil && isDeadlineExceeded(err) {
+			return api.deadlineExceededResult(ctx, pprRejectionSubject(pprRef), pod, cellId, kind, err)
+		}
+
+		if err != nil {
+			return HandleResult{
+				Status: observer.RequestStatusError,
+				Rejection: optional.Some(internalErrorRejection(
+					fmt.Sprintf("Cannot reserve PodProtector admission: %s", err.Error()),
+				)),
+				Err:      err,
+				Warnings: nil,
+			}
 		}
+
+		config = api.defaultConfig.Compute(optional.Some(pprObj.Spec.AdmissionHistoryConfig))
+		hints = pprRejectionHints(config, pprObj, api.clk.Now())
+	}
+
+	return toHandleResult(pprRejectionSubject(pprRef), result, kind, dryRun, hints)
+}
+
+// Computes the disruption result that PoolAdapter would return for a single pod
+// without modifying the PodProtector.
+func evaluateReadOnly(
+	config defaultconfig.Computed,
+	originalPpr *podseidonv1a1.PodProtector,
+	podUid types.UID,
+	cellId string,
+	now time.Time,
+) pprutil.DisruptionResult {
+	if cellIndex := util.FindInSliceWith(
+		originalPpr.Status.Cells,
+		func(cell podseidonv1a1.PodProtectorCellStatus) bool { return cell.CellId == cellId },
+	); cellIndex != -1 {
+		if util.FindInSliceWith(
+			originalPpr.Status.Cells[cellIndex].History.Buckets,
+			func(bucket podseidonv1a1.PodProtectorAdmissionBucket) bool {
+				return bucket.PodUid != nil && *bucket.PodUid == podUid
+			},
+		) != -1 {
+			return pprutil.DisruptionResultOk // already disrupted
+		}
+	}
+
+	ppr := originalPpr.DeepCopy()
+	pprutil.Summarize(config, ppr, now)
+
+	minAvailable := pprutil.EffectiveMinAvailable(ppr, pprutil.ActiveWindow(ppr.Spec, now))
+	quota := pprutil.ComputeDisruptionQuota(minAvailable, config, ppr.Status.Summary)
+
+	globalQuotas := []*pprutil.DisruptionQuota{&quota}
+	if rateQuota, hasRate := pprutil.ComputeDisruptionRateQuota(ppr, now).Get(); hasRate {
+		globalQuotas = append(globalQuotas, &rateQuota)
+	}
+
+	cellStatus := util.GetOrAppendSliceWith(
+		&ppr.Status.Cells,
+		func(cell *podseidonv1a1.PodProtectorCellStatus) bool { return cell.CellId == cellId },
+		func() podseidonv1a1.PodProtectorCellStatus {
+			return podseidonv1a1.PodProtectorCellStatus{CellId: cellId} //nolint:exhaustruct // new cell
+		},
+	)
+
+	return disruptInCell(ppr.Spec.CellConstraint, globalQuotas, map[string]*pprutil.DisruptionQuota{}, cellStatus)
+}
+
+// Extra information used to populate the rejection details.
+type rejectionHints struct {
+	// The quota observed from the informer copy of the subject, if known.
+	quota optional.Optional[RejectionQuota]
+	// Expected time until aggregator observes the outstanding admissions, if known.
+	bufferRetryAfter optional.Optional[time.Duration]
+	// Time until the disruption rate of the subject allows another deletion, if exhausted.
+	rateRetryAfter optional.Optional[time.Duration]
+}
+
+func noRejectionHints() rejectionHints {
+	return rejectionHints{
+		quota:            optional.None[RejectionQuota](),
+		bufferRetryAfter: optional.None[time.Duration](),
+		rateRetryAfter:   optional.None[time.Duration](),
+	}
+}
+
+// Computes the rejection hints of a PodProtector from its informer copy.
+//
+// The hints are only estimates, which may not include the admissions reserved in the same batch.
+func pprRejectionHints(config defaultconfig.Computed, originalPpr *podseidonv1a1.PodProtector, now time.Time) rejectionHints {
+	ppr := originalPpr.DeepCopy()
+	pprutil.Summarize(config, ppr, now)
+
+	minAvailable := pprutil.EffectiveMinAvailable(ppr, pprutil.ActiveWindow(ppr.Spec, now))
+
+	return rejectionHints{
+		quota: optional.Some(newRejectionQuota(
+			minAvailable,
+			ppr.Status.Summary,
+			pprutil.ComputeDisruptionQuota(minAvailable, config, ppr.Status.Summary),
+		)),
+		// Outstanding admissions are cleared after aggregator catches up with the slowest cell
+		// and writes the next aggregation.
+		bufferRetryAfter: optional.Some(
+			config.AggregationRate + time.Duration(ppr.Status.Summary.MaxLatencyMillis)*time.Millisecond,
+		),
+		rateRetryAfter: pprutil.DisruptionRateRetryAfter(ppr, now),
+	}
+}
+
+// Computes the rejection hints of a PodProtectorGroup from its informer copy.
+func (api Api) groupRejectionHints(groupRef pprutil.GroupKey) rejectionHints {
+	// Groups do not have their own admission history config, so the default aggregation rate is assumed.
+	defaultConfig := api.defaultConfig.Compute(optional.None[podseidonv1a1.AdmissionHistoryConfig]())
+
+	hints := noRejectionHints()
+	hints.bufferRetryAfter = optional.Some(defaultConfig.AggregationRate)
+
+	groupOptional, err := api.pprInformer.GetGroup(groupRef)
+	if err != nil {
+		return hints
+	}
+
+	originalGroup, present := groupOptional.Get()
+	if !present {
+		return hints
+	}
+
+	members, err := api.pprInformer.ListGroupMembers(groupRef)
+	if err != nil {
+		return hints
 	}
 
+	group := originalGroup.DeepCopy()
+	pprutil.PruneGroupAdmissions(group, members)
+
+	summary := pprutil.SummarizeGroup(group, members)
+	hints.quota = optional.Some(newRejectionQuota(
+		summary.MinAvailable,
+		summary,
+		pprutil.ComputeGroupDisruptionQuota(group, members),
+	))
+
+	return hints
+}
+
+func toHandleResult(
+	subject RejectionSubject,
+	result pprutil.DisruptionResult,
+	kind reviewKind,
+	dryRun bool,
+	hints rejectionHints,
+) HandleResult {
 	switch result {
 	case pprutil.DisruptionResultOk:
+		status := observer.RequestStatusAdmittedAll
+		if dryRun {
+			status = observer.RequestStatusDryRun
+		}
+
 		return HandleResult{
-			Status:    observer.RequestStatusAdmittedAll,
+			Status:    status,
 			Rejection: optional.None[Rejection](),
 			Err:       nil,
+			Warnings:  nil,
 		}
 	case pprutil.DisruptionResultDenied:
+		status := observer.RequestStatusRejected
+		if dryRun {
+			status = observer.RequestStatusDryRunRejected
+		}
+
 		return HandleResult{
-			Status: observer.RequestStatusRejected,
+			Status: status,
 			Rejection: optional.Some(Rejection{
-				Code: http.StatusBadRequest,
+				Code: deniedCode(kind),
 				Message: fmt.Sprintf(
-					"PodProtector %s/%s reports too few available replicas to admit pod deletion",
-					pprRef.Namespace, pprRef.Name,
+					"%s reports too few available replicas to admit pod deletion%s",
+					subject.DisplayName(),
+					staleCellsSuffix(hints.quota),
 				),
+				Reason:            podseidon.RejectionReasonInsufficientAvailability,
+				Subject:           optional.Some(subject),
+				Quota:             hints.quota,
+				RetryAfterSeconds: 0,
 			}),
-			Err: nil,
+			Err:      nil,
+			Warnings: nil,
 		}
 	case pprutil.DisruptionResultRetry:
+		status := observer.RequestStatusRetryAdvised
+		if dryRun {
+			status = observer.RequestStatusDryRunRejected
+		}
+
+		rejection := Rejection{
+			Code: retryCode(kind),
+			Message: fmt.Sprintf(
+				"%s has full admission buffer and is temporarily unable to admit pod deletion%s",
+				subject.DisplayName(),
+				staleCellsSuffix(hints.quota),
+			),
+			Reason:            podseidon.RejectionReasonAdmissionBufferFull,
+			Subject:           optional.Some(subject),
+			Quota:             hints.quota,
+			RetryAfterSeconds: 0,
+		}
+
+		if retryAfter, known := hints.bufferRetryAfter.Get(); known {
+			rejection.RetryAfterSeconds = ceilSeconds(retryAfter)
+		}
+
+		if retryAfter, rateExhausted := hints.rateRetryAfter.Get(); rateExhausted {
+			retryAfterSeconds := ceilSeconds(retryAfter)
+
+			rejection.Message = fmt.Sprintf(
+				"%s has exhausted its disruption rate; retry after %ds",
+				subject.DisplayName(),
+				retryAfterSeconds,
+			)
+			rejection.Reason = podseidon.RejectionReasonDisruptionRateExhausted
+			rejection.RetryAfterSeconds = retryAfterSeconds
+		}
+
 		return HandleResult{
-			Status: observer.RequestStatusRetryAdvised,
-			Rejection: optional.Some(Rejection{
-				Code: http.StatusConflict,
-				Message: fmt.Sprintf(
-					"PodProtector %s/%s has full admission buffer and is temporarily unable to admit pod deletion",
-					pprRef.Namespace,
-					pprRef.Name,
-				),
-			}),
-			Err: nil,
+			Status:    status,
+			Rejection: optional.Some(rejection),
+			Err:       nil,
+			Warnings:  nil,
 		}
 	default:
 		panic("invalid DisruptionResult value")
 	}
 }
 
+// Mentions the stale cells counted in the quota, if any, in a rejection message.
+func staleCellsSuffix(quotaOpt optional.Optional[RejectionQuota]) string {
+	quota, hasQuota := quotaOpt.Get()
+	if !hasQuota || quota.StaleCells == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf(" (%d cells with stale aggregation are counted conservatively)", quota.StaleCells)
+}
+
+// Rounds a retry delay up to whole seconds, with a minimum of one second.
+func ceilSeconds(duration time.Duration) int32 {
+	return max(1, int32(math.Ceil(duration.Seconds())))
+}
+
+// Eviction clients such as `kubectl drain` already retry on 429, the same code used for PDB violations.
+func deniedCode(kind reviewKind) uint16 {
+	if kind == reviewKindEviction {
+		return http.StatusTooManyRequests
+	}
+
+	return http.StatusBadRequest
+}
+
+func retryCode(kind reviewKind) uint16 {
+	if kind == reviewKindEviction {
+		return http.StatusTooManyRequests
+	}
+
+	return http.StatusConflict
+}
+
 type Rejection struct {
 	Code    uint16
 	Message string
+	// Stable reason code for the rejection, one of the `podseidon.RejectionReason*` constants.
+	Reason string
+	// The object that rejected the request, if any.
+	Subject optional.Optional[RejectionSubject]
+	// The quota of the subject when the request was rejected, if known.
+	Quota optional.Optional[RejectionQuota]
+	// Suggested delay before retrying, if known.
+	RetryAfterSeconds int32
+}
+
+// Returns a rejection for an internal error, which does not have a subject or quota.
+func internalErrorRejection(message string) Rejection {
+	return Rejection{
+		Code:              http.StatusInternalServerError,
+		Message:           message,
+		Reason:            podseidon.RejectionReasonInternalError,
+		Subject:           optional.None[RejectionSubject](),
+		Quota:             optional.None[RejectionQuota](),
+		RetryAfterSeconds: 0,
+	}
+}
+
+// Identifies a PodProtector, ClusterPodProtector or PodProtectorGroup.
+type RejectionSubject struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+func pprRejectionSubject(key pprutil.PodProtectorKey) RejectionSubject {
+	kind := podseidonv1a1.PodProtectorKind
+	if key.IsClusterScoped() {
+		kind = podseidonv1a1.ClusterPodProtectorKind
+	}
+
+	return RejectionSubject{Kind: kind, Namespace: key.Namespace, Name: key.Name}
+}
+
+func groupRejectionSubject(key pprutil.GroupKey) RejectionSubject {
+	return RejectionSubject{Kind: podseidonv1a1.PodProtectorGroupKind, Namespace: key.Namespace, Name: key.Name}
+}
+
+// Describes the subject in user-facing messages.
+func (subject RejectionSubject) DisplayName() string {
+	if subject.Namespace == "" {
+		return fmt.Sprintf("%s %s", subject.Kind, subject.Name)
+	}
+
+	return fmt.Sprintf("%s %s/%s", subject.Kind, subject.Namespace, subject.Name)
+}
+
+type RejectionQuota struct {
+	MinAvailable        int32
+	AggregatedAvailable int32
+	EstimatedAvailable  int32
+	// Number of cells counted with the stale cell policy.
+	StaleCells int32
+	Quota      pprutil.DisruptionQuota
+}
+
+func newRejectionQuota(
+	minAvailable int32,
+	summary podseidonv1a1.PodProtectorStatusSummary,
+	quota pprutil.DisruptionQuota,
+) RejectionQuota {
+	return RejectionQuota{
+		MinAvailable:        minAvailable,
+		AggregatedAvailable: summary.AggregatedAvailable,
+		EstimatedAvailable:  summary.EstimatedAvailable,
+		StaleCells:          summary.StaleCells,
+		Quota:               quota,
+	}
 }
 
 func (rejection Rejection) ToStatus() *metav1.Status {
-	return &metav1.Status{
+	status := &metav1.Status{
 		Code:    int32(rejection.Code),
 		Message: rejection.Message,
 	}
+
+	if rejection.Code == http.StatusTooManyRequests {
+		status.Reason = metav1.StatusReasonTooManyRequests
+	}
+
+	//nolint:exhaustruct // UID is not applicable
+	details := &metav1.StatusDetails{RetryAfterSeconds: rejection.RetryAfterSeconds}
+
+	addCause := func(causeType string, value string) {
+		details.Causes = append(details.Causes, metav1.StatusCause{
+			Type:    metav1.CauseType(causeType),
+			Message: value,
+			Field:   "",
+		})
+	}
+
+	if rejection.Reason != "" {
+		addCause(podseidon.StatusCauseReason, rejection.Reason)
+	}
+
+	if subject, hasSubject := rejection.Subject.Get(); hasSubject {
+		details.Group = podseidonv1a1.SchemeGroupVersion.Group
+		details.Kind = subject.Kind
+		details.Name = subject.Name
+
+		if subject.Namespace != "" {
+			addCause(podseidon.StatusCauseNamespace, subject.Namespace)
+		}
+	}
+
+	if quota, hasQuota := rejection.Quota.Get(); hasQuota {
+		addCause(podseidon.StatusCauseMinAvailable, strconv.Itoa(int(quota.MinAvailable)))
+		addCause(podseidon.StatusCauseAggregatedAvailable, strconv.Itoa(int(quota.AggregatedAvailable)))
+		addCause(podseidon.StatusCauseEstimatedAvailable, strconv.Itoa(int(quota.EstimatedAvailable)))
+		addCause(podseidon.StatusCauseQuotaCleared, strconv.Itoa(int(quota.Quota.Cleared)))
+		addCause(podseidon.StatusCauseQuotaTransitional, strconv.Itoa(int(quota.Quota.Transitional)))
+
+		if quota.StaleCells > 0 {
+			addCause(podseidon.StatusCauseStaleCells, strconv.Itoa(int(quota.StaleCells)))
+		}
+	}
+
+	if details.RetryAfterSeconds > 0 || details.Kind != "" || len(details.Causes) > 0 {
+		status.Details = details
+	}
+
+	return status
 }
 
 func jitterDuration(base, jitter time.Duration) time.Duration {
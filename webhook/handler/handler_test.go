@@ -0,0 +1,76 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	podseidon "github.com/kubewharf/podseidon/apis"
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
+
+	"github.com/kubewharf/podseidon/util/optional"
+	pprutil "github.com/kubewharf/podseidon/util/podprotector"
+
+	"github.com/kubewharf/podseidon/webhook/handler"
+)
+
+func TestRejectionToStatus(t *testing.T) {
+	t.Parallel()
+
+	status := handler.Rejection{
+		Code:    http.StatusTooManyRequests,
+		Message: "retry later",
+		Reason:  podseidon.RejectionReasonAdmissionBufferFull,
+		Subject: optional.Some(handler.RejectionSubject{
+			Kind:      podseidonv1a1.PodProtectorKind,
+			Namespace: "ns",
+			Name:      "app",
+		}),
+		Quota: optional.Some(handler.RejectionQuota{
+			MinAvailable:        8,
+			AggregatedAvailable: 9,
+			EstimatedAvailable:  8,
+			Quota:               pprutil.DisruptionQuota{Cleared: 0, Transitional: 1},
+		}),
+		RetryAfterSeconds: 3,
+	}.ToStatus()
+
+	assert.Equal(t, metav1.StatusReasonTooManyRequests, status.Reason)
+	require.NotNil(t, status.Details)
+	assert.Equal(t, int32(3), status.Details.RetryAfterSeconds)
+	assert.Equal(t, podseidonv1a1.SchemeGroupVersion.Group, status.Details.Group)
+	assert.Equal(t, podseidonv1a1.PodProtectorKind, status.Details.Kind)
+	assert.Equal(t, "app", status.Details.Name)
+
+	causes := map[metav1.CauseType]string{}
+	for _, cause := range status.Details.Causes {
+		causes[cause.Type] = cause.Message
+	}
+
+	assert.Equal(t, map[metav1.CauseType]string{
+		podseidon.StatusCauseReason:              podseidon.RejectionReasonAdmissionBufferFull,
+		podseidon.StatusCauseNamespace:           "ns",
+		podseidon.StatusCauseMinAvailable:        "8",
+		podseidon.StatusCauseAggregatedAvailable: "9",
+		podseidon.StatusCauseEstimatedAvailable:  "8",
+		podseidon.StatusCauseQuotaCleared:        "0",
+		podseidon.StatusCauseQuotaTransitional:   "1",
+	}, causes)
+}
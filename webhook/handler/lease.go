@@ -0,0 +1,309 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/utils/clock"
+
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
+
+	"github.com/kubewharf/podseidon/util/errors"
+	"github.com/kubewharf/podseidon/util/optional"
+	pprutil "github.com/kubewharf/podseidon/util/podprotector"
+	"github.com/kubewharf/podseidon/util/retrybatch"
+	"github.com/kubewharf/podseidon/util/util"
+
+	"github.com/kubewharf/podseidon/webhook/observer"
+)
+
+// Quota leases held by this webhook replica.
+//
+// A lease is reserved in the same status write as the admission of a pod,
+// after which subsequent deletions are admitted locally until the lease is returned.
+// The lease is returned, together with the admission buckets of the deletions admitted against it,
+// when it is exhausted, when no deletion is admitted against it for batchGoroutineIdleTimeout,
+// or when half of its duration has elapsed.
+// The other half of the duration allows the return to be retried before the lease expires.
+//
+// If the replica terminates without returning the lease,
+// the reserved quota is only released after the lease expires
+// and aggregator has observed the deletions admitted against it (see pprutil.IsLeaseActive).
+type leaseStore struct {
+	holder   string
+	size     int32
+	duration time.Duration
+
+	clk      clock.Clock
+	observer observer.Observer
+	pool     util.LateInitReader[retrybatch.Pool[pprutil.PodProtectorKey, BatchArg, pprutil.DisruptionResult]]
+
+	//nolint:containedctx // this context is not function-scoped; it is used for running lease return goroutines.
+	ctx context.Context
+
+	mu      sync.Mutex
+	nextSeq uint64
+	leases  map[pprutil.PodProtectorKey]*localLease
+}
+
+type localLease struct {
+	id    string
+	quota int32
+
+	// No more deletions are admitted against the lease after this time.
+	usableUntil time.Time
+	lastActive  time.Time
+	admissions  []observer.LeaseAdmission
+	// Closed when all quota of the lease has been used.
+	exhausted chan util.Empty
+}
+
+func newLeaseStore(
+	clk clock.Clock,
+	obs observer.Observer,
+	pool util.LateInitReader[retrybatch.Pool[pprutil.PodProtectorKey, BatchArg, pprutil.DisruptionResult]],
+	size int32,
+	duration time.Duration,
+) (*leaseStore, error) {
+	holder := ""
+
+	if size > 0 {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, errors.TagWrapf("GetHostname", err, "get hostname")
+		}
+
+		holder = fmt.Sprintf("%s_%s", hostname, string(uuid.NewUUID()))
+	}
+
+	return &leaseStore{
+		holder:   holder,
+		size:     size,
+		duration: duration,
+		clk:      clk,
+		observer: obs,
+		pool:     pool,
+		ctx:      context.Background(),
+		mu:       sync.Mutex{},
+		nextSeq:  0,
+		leases:   map[pprutil.PodProtectorKey]*localLease{},
+	}, nil
+}
+
+func (store *leaseStore) enabled() bool {
+	return store.size > 0
+}
+
+// Leases are only used for PodProtectors whose quota does not depend on the cell or time of each admission.
+//
+// Windows are excluded since deletions admitted against a lease
+// would bypass a freeze window or minAvailable override that becomes active while the lease is held.
+func leaseEligible(spec podseidonv1a1.PodProtectorSpec) bool {
+	return spec.CellConstraint == nil && spec.DisruptionRate == nil && len(spec.Windows) == 0
+}
+
+func (store *leaseStore) newLeaseId() string {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.nextSeq++
+
+	return fmt.Sprintf("%s-%d", store.holder, store.nextSeq)
+}
+
+// Attempts to admit the pod against the lease held for the PodProtector.
+//
+// Returns whether the pod is admitted, and whether a usable lease is held,
+// in which case the caller should not request another lease.
+func (store *leaseStore) admit(key pprutil.PodProtectorKey, arg BatchArg) (_admitted bool, _held bool) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	lease, held := store.leases[key]
+	if !held {
+		return false, false
+	}
+
+	now := store.clk.Now()
+	if !now.Before(lease.usableUntil) {
+		return false, true
+	}
+
+	if util.FindInSliceWith(
+		lease.admissions,
+		func(admission observer.LeaseAdmission) bool { return admission.PodUid == arg.PodUid },
+	) != -1 {
+		return true, true // already admitted
+	}
+
+	if int32(len(lease.admissions)) >= lease.quota {
+		return false, true
+	}
+
+	lease.admissions = append(lease.admissions, observer.LeaseAdmission{
+		CellId:    arg.CellId,
+		PodUid:    arg.PodUid,
+		PodName:   arg.PodName,
+		Username:  arg.Username,
+		Operation: arg.Operation,
+		Time:      now,
+	})
+	lease.lastActive = now
+
+	if int32(len(lease.admissions)) == lease.quota {
+		select {
+		case <-lease.exhausted:
+			// already closed before a rollback freed some quota
+		default:
+			close(lease.exhausted)
+		}
+	}
+
+	return true, true
+}
+
+// Removes the admission of the pod from the lease held for the PodProtector.
+//
+// Returns false if the pod was not admitted against a lease that is still held,
+// in which case the admission bucket should be rolled back through the pool instead.
+func (store *leaseStore) rollback(key pprutil.PodProtectorKey, podUid types.UID) bool {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	lease, held := store.leases[key]
+	if !held {
+		return false
+	}
+
+	index := util.FindInSliceWith(
+		lease.admissions,
+		func(admission observer.LeaseAdmission) bool { return admission.PodUid == podUid },
+	)
+	if index == -1 {
+		return false
+	}
+
+	// The exhausted channel may already be closed, but the lease is returned soon anyway.
+	lease.admissions = append(lease.admissions[:index], lease.admissions[index+1:]...)
+
+	return true
+}
+
+// Installs a lease that has been written to the PodProtector status.
+//
+// A lease granted while another lease is still held is returned immediately,
+// since its quota would otherwise stay reserved until it expires.
+func (store *leaseStore) grant(key pprutil.PodProtectorKey, granted podseidonv1a1.PodProtectorQuotaLease, grantTime time.Time) {
+	lease := &localLease{
+		id:          granted.Id,
+		quota:       granted.Quota,
+		usableUntil: grantTime.Add(store.duration / 2),
+		lastActive:  grantTime,
+		admissions:  []observer.LeaseAdmission{},
+		exhausted:   make(chan util.Empty),
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	if _, held := store.leases[key]; held {
+		close(lease.exhausted)
+	} else {
+		store.leases[key] = lease
+	}
+
+	go store.runLease(key, lease)
+}
+
+// Waits until the lease should be returned, then returns it through the pool.
+func (store *leaseStore) runLease(key pprutil.PodProtectorKey, lease *localLease) {
+	ctx := store.ctx
+
+	for {
+		store.mu.Lock()
+		returnTime := lease.usableUntil
+		if idleTime := lease.lastActive.Add(batchGoroutineIdleTimeout); idleTime.Before(returnTime) {
+			returnTime = idleTime
+		}
+		store.mu.Unlock()
+
+		wait := returnTime.Sub(store.clk.Now())
+		if wait <= 0 {
+			break
+		}
+
+		timer := store.clk.NewTimer(wait)
+
+		select {
+		case <-timer.C():
+		case <-lease.exhausted:
+			timer.Stop()
+
+			store.returnLease(ctx, key, lease)
+
+			return
+		case <-ctx.Done():
+			timer.Stop()
+
+			return
+		}
+	}
+
+	store.returnLease(ctx, key, lease)
+}
+
+func (store *leaseStore) returnLease(ctx context.Context, key pprutil.PodProtectorKey, lease *localLease) {
+	store.mu.Lock()
+	if store.leases[key] == lease {
+		delete(store.leases, key)
+	}
+
+	admissions := lease.admissions
+	store.mu.Unlock()
+
+	_, err := store.pool.Get().Submit(ctx, key, BatchArg{
+		CellId:       "",
+		PodUid:       "",
+		PodName:      "",
+		Username:     "",
+		Operation:    "",
+		Rollback:     false,
+		AcquireLease: false,
+		ReturnLease: optional.Some(observer.LeaseReturn{
+			LeaseId:    lease.id,
+			Admissions: admissions,
+		}),
+	})
+	if err != nil {
+		err = errors.TagWrapf("ReturnLease", err, "cannot return quota lease")
+	}
+
+	store.observer.QuotaLease(ctx, observer.QuotaLease{
+		Namespace: key.Namespace,
+		PprName:   key.Name,
+		LeaseId:   lease.id,
+		Action:    observer.QuotaLeaseActionReturn,
+		Quota:     lease.quota,
+		Used:      int32(len(admissions)),
+		Err:       err,
+	})
+}
@@ -0,0 +1,399 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	authnv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/json"
+	clocktesting "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
+
+	"github.com/kubewharf/podseidon/util/cmd"
+	"github.com/kubewharf/podseidon/util/component"
+	"github.com/kubewharf/podseidon/util/defaultconfig"
+	"github.com/kubewharf/podseidon/util/errors"
+	"github.com/kubewharf/podseidon/util/kube"
+	"github.com/kubewharf/podseidon/util/o11y"
+	"github.com/kubewharf/podseidon/util/optional"
+	pprutil "github.com/kubewharf/podseidon/util/podprotector"
+
+	"github.com/kubewharf/podseidon/webhook/handler"
+	"github.com/kubewharf/podseidon/webhook/observer"
+)
+
+const (
+	leaseTestPprName = "ppr"
+	leaseTestCellId  = "cell"
+	leaseTestSize    = 2
+)
+
+func TestQuotaLeaseExhausted(t *testing.T) {
+	t.Parallel()
+
+	env := setupLeaseTest(t, func(clk *clocktesting.FakeClock, ppr *podseidonv1a1.PodProtector) {
+		lastEventTime := ppr.Status.Cells[0].Aggregation.LastEventTime.Time
+
+		ppr.Status.Leases = []podseidonv1a1.PodProtectorQuotaLease{
+			// Expired and observed by aggregator, no longer reserves quota.
+			{Id: "observed", Quota: 1, ExpiryTime: metav1.MicroTime{Time: lastEventTime.Add(-time.Second)}},
+			// Expired but not observed by aggregator yet, still reserves quota.
+			{Id: "unobserved", Quota: 1, ExpiryTime: metav1.MicroTime{Time: clk.Now().Add(-time.Second)}},
+		}
+	})
+
+	// Available 10, minAvailable 5, 1 unobserved lease: 4 deletions are allowed.
+	// The first deletion is admitted through the pool and acquires a lease of 2.
+	env.assertAdmitted(t, "pod-0")
+
+	ppr := env.waitForInformer(t)
+	assert.Equal(t, []string{"unobserved", "ownLease"}, leaseIdsOrOwn(ppr.Status.Leases, "unobserved"))
+	assert.Equal(t, int32(leaseTestSize), ppr.Status.Leases[1].Quota)
+	assert.Equal(t, []string{"pod-0"}, bucketPodUids(ppr))
+	assert.Equal(t, int32(leaseTestSize+1), ppr.Status.Summary.Leased)
+	assert.Equal(t, int32(6), ppr.Status.Summary.EstimatedAvailable)
+
+	acquire := <-env.leaseEvents
+	assert.Equal(t, observer.QuotaLeaseActionAcquire, acquire.Action)
+
+	// Deletions admitted against the lease are not written until the lease is returned.
+	env.assertAdmitted(t, "pod-1")
+	assert.Equal(t, []string{"pod-0"}, bucketPodUids(env.getPpr(t)))
+
+	// Exhausting the lease returns it immediately.
+	env.assertAdmitted(t, "pod-2")
+
+	returned := <-env.leaseEvents
+	assert.Equal(t, observer.QuotaLeaseActionReturn, returned.Action)
+	assert.Equal(t, acquire.LeaseId, returned.LeaseId)
+	assert.Equal(t, int32(2), returned.Used)
+	require.NoError(t, returned.Err)
+
+	ppr = env.waitForInformer(t)
+	assert.Equal(t, []string{"unobserved"}, leaseIdsOrOwn(ppr.Status.Leases, "unobserved"))
+	assert.Equal(t, []string{"pod-0", "pod-1", "pod-2"}, bucketPodUids(ppr))
+	assert.Equal(t, int32(1), ppr.Status.Summary.Leased)
+	assert.Equal(t, int32(6), ppr.Status.Summary.EstimatedAvailable)
+
+	// The last deletion of the quota is admitted through the pool without a lease.
+	env.assertAdmitted(t, "pod-3")
+
+	ppr = env.waitForInformer(t)
+	assert.Equal(t, []string{"unobserved"}, leaseIdsOrOwn(ppr.Status.Leases, "unobserved"))
+	assert.Equal(t, []string{"pod-0", "pod-1", "pod-2", "pod-3"}, bucketPodUids(ppr))
+
+	env.assertRejected(t, "pod-4")
+}
+
+func TestQuotaLeaseIdle(t *testing.T) {
+	t.Parallel()
+
+	env := setupLeaseTest(t, func(*clocktesting.FakeClock, *podseidonv1a1.PodProtector) {})
+
+	env.assertAdmitted(t, "pod-0")
+	env.waitForInformer(t)
+
+	acquire := <-env.leaseEvents
+	assert.Equal(t, observer.QuotaLeaseActionAcquire, acquire.Action)
+
+	env.assertAdmitted(t, "pod-1")
+
+	// The lease is returned after it stays idle for a second.
+	require.Eventually(t, env.clk.HasWaiters, time.Second*5, time.Millisecond*10)
+	env.clk.Step(time.Second)
+
+	returned := <-env.leaseEvents
+	assert.Equal(t, observer.QuotaLeaseActionReturn, returned.Action)
+	assert.Equal(t, acquire.LeaseId, returned.LeaseId)
+	assert.Equal(t, int32(1), returned.Used)
+	require.NoError(t, returned.Err)
+
+	ppr := env.waitForInformer(t)
+	assert.Empty(t, ppr.Status.Leases)
+	assert.Equal(t, []string{"pod-0", "pod-1"}, bucketPodUids(ppr))
+	assert.Equal(t, int32(8), ppr.Status.Summary.EstimatedAvailable)
+}
+
+func TestQuotaLeaseIneligibleWithWindows(t *testing.T) {
+	t.Parallel()
+
+	env := setupLeaseTest(t, func(clk *clocktesting.FakeClock, ppr *podseidonv1a1.PodProtector) {
+		ppr.Spec.Windows = []podseidonv1a1.PodProtectorWindow{
+			{
+				Name:   "later",
+				Start:  &metav1.Time{Time: clk.Now().Add(time.Hour)},
+				End:    &metav1.Time{Time: clk.Now().Add(time.Hour * 2)},
+				Action: podseidonv1a1.PodProtectorWindowActionFreeze,
+			},
+		}
+	})
+
+	env.assertAdmitted(t, "pod-0")
+
+	ppr := env.waitForInformer(t)
+	assert.Empty(t, ppr.Status.Leases)
+	assert.Equal(t, []string{"pod-0"}, bucketPodUids(ppr))
+
+	env.assertAdmitted(t, "pod-1")
+
+	ppr = env.waitForInformer(t)
+	assert.Equal(t, []string{"pod-0", "pod-1"}, bucketPodUids(ppr), "each deletion is written without a lease")
+}
+
+type leaseTestEnv struct {
+	ctx         context.Context //nolint:containedctx // test scope
+	clk         *clocktesting.FakeClock
+	client      *kube.Client
+	api         handler.Api
+	informer    pprutil.IndexedInformer
+	leaseEvents chan observer.QuotaLease
+}
+
+func setupLeaseTest(
+	t *testing.T,
+	mutatePpr func(clk *clocktesting.FakeClock, ppr *podseidonv1a1.PodProtector),
+) *leaseTestEnv {
+	t.Helper()
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	clk := clocktesting.NewFakeClock(time.Now())
+
+	//nolint:exhaustruct
+	ppr := &podseidonv1a1.PodProtector{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: podseidonv1a1.SchemeGroupVersion.String(),
+			Kind:       podseidonv1a1.PodProtectorKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      leaseTestPprName,
+		},
+		Spec: podseidonv1a1.PodProtectorSpec{
+			MinAvailable: 5,
+			Selector:     metav1.LabelSelector{MatchLabels: map[string]string{"test": "true"}},
+		},
+		Status: podseidonv1a1.PodProtectorStatus{
+			Cells: []podseidonv1a1.PodProtectorCellStatus{
+				{
+					CellId: leaseTestCellId,
+					Aggregation: podseidonv1a1.PodProtectorAggregation{
+						TotalReplicas:     10,
+						AvailableReplicas: 10,
+						LastEventTime:     metav1.MicroTime{Time: clk.Now().Add(-time.Second * 10)},
+					},
+				},
+			},
+		},
+	}
+	mutatePpr(clk, ppr)
+
+	client := kube.MockClient(ppr)
+
+	leaseEvents := make(chan observer.QuotaLease, 16)
+
+	//nolint:exhaustruct
+	obs := o11y.ReflectPopulate(observer.Observer{
+		QuotaLease: func(_ context.Context, arg observer.QuotaLease) {
+			leaseEvents <- arg
+		},
+	})
+
+	informerDecl := pprutil.NewIndexedInformer(pprutil.IndexedInformerArgs{
+		Suffix:  "",
+		Elector: optional.None[kube.ElectorArgs](),
+	})
+
+	apiMap := cmd.MockStartupWithCliArgs(ctx, []func(*component.DepRequests){
+		component.ApiOnly("core-kube", client),
+		component.ApiOnly("observer-webhook", obs),
+		component.ApiOnly("default-admission-history-config", &defaultconfig.Options{
+			MaxConcurrentLag:   ptr.To(int32(0)),
+			CompactThreshold:   ptr.To(int32(100)),
+			AggregationRate:    ptr.To(time.Duration(0)),
+			StaleCellThreshold: ptr.To(time.Duration(0)),
+			StaleCellPolicy:    ptr.To(podseidonv1a1.StaleCellPolicyEstimated),
+		}),
+		component.ApiOnly[handler.RequiresPodName](handler.RequiresPodNameMuxName, handler.ConstantRequiresPodName(true)),
+		component.ApiOnly[handler.DeleterIdentity](handler.DeleterIdentityMuxName, leaseTestDeps{}),
+		component.ApiOnly[handler.PodGetter](handler.PodGetterMuxName, leaseTestDeps{}),
+		component.RequireDep(handler.New(handler.Args{Clock: clk})),
+		component.RequireDep(informerDecl),
+		pprutil.RequireSingleSourceProvider(pprutil.SingleSourceProviderArgs{ClusterName: "core"}, true),
+	}, []string{
+		fmt.Sprintf("--webhook-handler-lease-size=%d", leaseTestSize),
+		"--webhook-handler-lease-duration=10s",
+	})
+
+	env := &leaseTestEnv{
+		ctx:         ctx,
+		clk:         clk,
+		client:      client,
+		api:         component.ApiFromMap[handler.Api](apiMap, "webhook-handler"),
+		informer:    component.ApiFromMap[pprutil.IndexedInformer](apiMap, "podprotector-indexed-informer-"),
+		leaseEvents: leaseEvents,
+	}
+
+	require.Eventually(t, env.informer.HasSynced, time.Second*5, time.Millisecond*10)
+
+	return env
+}
+
+// Records no deleter identity and never fetches pods, since reviews in these tests always include oldObject.
+type leaseTestDeps struct{}
+
+func (leaseTestDeps) DeleterIdentity(handler.DeleterIdentityArg) string { return "" }
+
+func (leaseTestDeps) GetPod(context.Context, handler.PodGetterArg) (*corev1.Pod, error) {
+	return nil, errors.TagErrorf("UnexpectedGetPod", "reviews in this test include oldObject")
+}
+
+func (env *leaseTestEnv) handle(t *testing.T, podName string) handler.HandleResult {
+	t.Helper()
+
+	//nolint:exhaustruct
+	pod := &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      podName,
+			UID:       types.UID(podName),
+			Labels:    map[string]string{"test": "true"},
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{
+				{
+					Type:               corev1.PodReady,
+					Status:             corev1.ConditionTrue,
+					LastTransitionTime: metav1.Time{Time: env.clk.Now().Add(-time.Hour)},
+				},
+			},
+		},
+	}
+
+	podJson, err := json.Marshal(pod)
+	require.NoError(t, err)
+
+	//nolint:exhaustruct
+	req := &admissionv1.AdmissionRequest{
+		UID:       types.UID("review-" + podName),
+		Resource:  metav1.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"},
+		Operation: admissionv1.Delete,
+		Namespace: metav1.NamespaceDefault,
+		Name:      podName,
+		OldObject: runtime.RawExtension{Raw: podJson},
+		UserInfo:  authnv1.UserInfo{Username: "test"},
+	}
+
+	result, _ := env.api.Handle(env.ctx, req, leaseTestCellId, map[string]string{}, nil)
+	require.NoError(t, result.Err)
+
+	return result
+}
+
+func (env *leaseTestEnv) assertAdmitted(t *testing.T, podName string) {
+	t.Helper()
+
+	result := env.handle(t, podName)
+	assert.False(t, result.Rejection.IsSome(), "%s should be admitted", podName)
+}
+
+func (env *leaseTestEnv) assertRejected(t *testing.T, podName string) {
+	t.Helper()
+
+	result := env.handle(t, podName)
+	assert.True(t, result.Rejection.IsSome(), "%s should be rejected", podName)
+}
+
+func (env *leaseTestEnv) getPpr(t *testing.T) *podseidonv1a1.PodProtector {
+	t.Helper()
+
+	ppr, err := env.client.PodseidonClientSet().
+		PodseidonV1alpha1().
+		PodProtectors(metav1.NamespaceDefault).
+		Get(env.ctx, leaseTestPprName, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	return ppr
+}
+
+// Waits until the informer observes the latest PodProtector status,
+// since the fake client does not detect conflicting writes from a stale informer copy.
+func (env *leaseTestEnv) waitForInformer(t *testing.T) *podseidonv1a1.PodProtector {
+	t.Helper()
+
+	ppr := env.getPpr(t)
+
+	keys := env.informer.Query(metav1.NamespaceDefault, labels.Set{"test": "true"})
+	require.Len(t, keys, 1)
+
+	require.Eventually(t, func() bool {
+		cached, err := env.informer.Get(keys[0])
+		require.NoError(t, err)
+
+		cachedPpr, present := cached.Get()
+
+		return present && equality.Semantic.DeepEqual(cachedPpr.Status, ppr.Status)
+	}, time.Second*5, time.Millisecond*10)
+
+	return ppr
+}
+
+// Returns the lease IDs, replacing the IDs of leases other than `foreignIds` by "ownLease".
+func leaseIdsOrOwn(leases []podseidonv1a1.PodProtectorQuotaLease, foreignIds ...string) []string {
+	ids := make([]string, 0, len(leases))
+
+	for _, lease := range leases {
+		id := "ownLease"
+
+		for _, foreignId := range foreignIds {
+			if lease.Id == foreignId {
+				id = lease.Id
+			}
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+func bucketPodUids(ppr *podseidonv1a1.PodProtector) []string {
+	uids := []string{}
+
+	for _, cell := range ppr.Status.Cells {
+		for _, bucket := range cell.History.Buckets {
+			uids = append(uids, string(ptr.Deref(bucket.PodUid, "")))
+		}
+	}
+
+	return uids
+}
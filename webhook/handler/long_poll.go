@@ -0,0 +1,234 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/utils/clock"
+
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
+
+	"github.com/kubewharf/podseidon/util/errors"
+	pprutil "github.com/kubewharf/podseidon/util/podprotector"
+	"github.com/kubewharf/podseidon/util/util"
+
+	"github.com/kubewharf/podseidon/webhook/observer"
+)
+
+// Wakes up requests waiting for a PodProtector to change in the informer.
+type pprWaiters struct {
+	mu      sync.Mutex
+	waiters map[pprutil.PodProtectorKey][]chan util.Empty
+}
+
+func newPprWaiters() *pprWaiters {
+	return &pprWaiters{
+		mu:      sync.Mutex{},
+		waiters: map[pprutil.PodProtectorKey][]chan util.Empty{},
+	}
+}
+
+// Returns a channel closed after the next informer event of the PodProtector,
+// and a function that unsubscribes the channel if it is no longer awaited.
+//
+// The unsubscribe function must be called on every path that stops waiting on the channel,
+// otherwise the channel stays registered until the next event of the same PodProtector.
+func (waiters *pprWaiters) wait(key pprutil.PodProtectorKey) (<-chan util.Empty, func()) {
+	waiters.mu.Lock()
+	defer waiters.mu.Unlock()
+
+	ch := make(chan util.Empty)
+	waiters.waiters[key] = append(waiters.waiters[key], ch)
+
+	return ch, func() { waiters.unsubscribe(key, ch) }
+}
+
+func (waiters *pprWaiters) unsubscribe(key pprutil.PodProtectorKey, ch chan util.Empty) {
+	waiters.mu.Lock()
+	defer waiters.mu.Unlock()
+
+	keyWaiters := waiters.waiters[key]
+
+	// The channel is already removed if notify has been called.
+	if index := util.FindInSlice(keyWaiters, ch); index != -1 {
+		util.SwapRemove(&keyWaiters, index)
+	}
+
+	if len(keyWaiters) == 0 {
+		delete(waiters.waiters, key)
+	} else {
+		waiters.waiters[key] = keyWaiters
+	}
+}
+
+// Informer post handler.
+func (waiters *pprWaiters) notify(key pprutil.PodProtectorKey) {
+	waiters.mu.Lock()
+	defer waiters.mu.Unlock()
+
+	for _, ch := range waiters.waiters[key] {
+		close(ch)
+	}
+
+	delete(waiters.waiters, key)
+}
+
+// Waits for newer aggregation of the PodProtector and re-runs the quota check through the pool,
+// until the result is no longer DisruptionResultRetry or the deadline is reached.
+//
+// Returns the last result and the latest informer copy of the PodProtector.
+func (api Api) longPollRetry(
+	ctx context.Context,
+	pprRef pprutil.PodProtectorKey,
+	pprObj *podseidonv1a1.PodProtector,
+	deadline time.Time,
+	arg BatchArg,
+) (pprutil.DisruptionResult, *podseidonv1a1.PodProtector, error) {
+	startTime := api.clk.Now()
+
+	result := pprutil.DisruptionResultRetry
+	attempts := 0
+
+	var err error
+
+	for result == pprutil.DisruptionResultRetry {
+		var changed bool
+
+		changed, pprObj, err = api.waitForAggregation(ctx, pprRef, pprObj, deadline)
+		if err != nil || !changed {
+			break
+		}
+
+		attempts++
+
+		result, err = api.state.poolReader.Get().Submit(ctx, pprRef, arg)
+		if err != nil {
+			err = errors.TagWrapf("ReserveAdmission", err, "cannot reserve PodProtector admission")
+
+			break
+		}
+	}
+
+	api.observer.LongPollRetry(ctx, observer.LongPollRetry{
+		Namespace: pprRef.Namespace,
+		PprName:   pprRef.Name,
+		PodName:   arg.PodName,
+		PodCell:   arg.CellId,
+		Waited:    api.clk.Since(startTime),
+		Attempts:  attempts,
+		Admitted:  err == nil && result == pprutil.DisruptionResultOk,
+		Err:       err,
+	})
+
+	return result, pprObj, err
+}
+
+// Blocks until the informer copy of the PodProtector shows a different aggregation from `observed`.
+//
+// Returns false if the deadline is reached first.
+// A deleted PodProtector is considered changed, since the pool admits the pod in that case.
+func (api Api) waitForAggregation(
+	ctx context.Context,
+	pprRef pprutil.PodProtectorKey,
+	observed *podseidonv1a1.PodProtector,
+	deadline time.Time,
+) (_changed bool, _latest *podseidonv1a1.PodProtector, _ error) {
+	remaining := deadline.Sub(api.clk.Now())
+	if remaining <= 0 {
+		return false, observed, nil
+	}
+
+	timer := api.clk.NewTimer(remaining)
+	defer timer.Stop()
+
+	for {
+		changed, latest, done, err := api.awaitAggregationEvent(ctx, pprRef, observed, timer)
+		if done || err != nil {
+			return changed, latest, err
+		}
+	}
+}
+
+// Checks the informer copy of the PodProtector once and waits for its next informer event.
+//
+// Returns done=false if an informer event was received without a changed aggregation,
+// in which case the caller should check again.
+func (api Api) awaitAggregationEvent(
+	ctx context.Context,
+	pprRef pprutil.PodProtectorKey,
+	observed *podseidonv1a1.PodProtector,
+	timer clock.Timer,
+) (_changed bool, _latest *podseidonv1a1.PodProtector, _done bool, _ error) {
+	// Subscribe before reading the informer so that an event between the two is not missed.
+	notify, unsubscribe := api.state.pprWaiters.wait(pprRef)
+	defer unsubscribe()
+
+	pprOptional, err := api.pprInformer.Get(pprRef)
+	if err != nil {
+		return false, observed, true, errors.TagWrapf(
+			"GetPprFromInformer",
+			err,
+			"cannot fetch PodProtector from informer store",
+		)
+	}
+
+	latest, present := pprOptional.Get()
+	if !present {
+		return true, observed, true, nil
+	}
+
+	if aggregationChanged(observed, latest) {
+		return true, latest, true, nil
+	}
+
+	select {
+	case <-notify:
+		return false, latest, false, nil
+	case <-timer.C():
+		return false, latest, true, nil
+	case <-ctx.Done():
+		return false, latest, true, errors.TagWrapf("LongPollCanceled", ctx.Err(), "request canceled during long-poll")
+	}
+}
+
+// Whether aggregator has written a different aggregation for any cell.
+//
+// Admission buckets written by webhook replicas do not count,
+// since they can only decrease the available quota.
+func aggregationChanged(before, after *podseidonv1a1.PodProtector) bool {
+	if len(before.Status.Cells) != len(after.Status.Cells) {
+		return true
+	}
+
+	for _, afterCell := range after.Status.Cells {
+		beforeIndex := util.FindInSliceWith(
+			before.Status.Cells,
+			func(cell podseidonv1a1.PodProtectorCellStatus) bool { return cell.CellId == afterCell.CellId },
+		)
+		if beforeIndex == -1 {
+			return true
+		}
+
+		if !equality.Semantic.DeepEqual(before.Status.Cells[beforeIndex].Aggregation, afterCell.Aggregation) {
+			return true
+		}
+	}
+
+	return false
+}
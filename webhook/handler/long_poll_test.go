@@ -0,0 +1,87 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
+)
+
+func TestLongPollTimeoutUnsubscribes(t *testing.T) {
+	t.Parallel()
+
+	env := setupLeaseTest(t, func(*clocktesting.FakeClock, *podseidonv1a1.PodProtector) {})
+	assertLongPollUnsubscribes(t, env, func(context.CancelFunc) {
+		env.clk.Step(time.Second * 2)
+	})
+}
+
+func TestLongPollCancelUnsubscribes(t *testing.T) {
+	t.Parallel()
+
+	env := setupLeaseTest(t, func(*clocktesting.FakeClock, *podseidonv1a1.PodProtector) {})
+	assertLongPollUnsubscribes(t, env, func(cancel context.CancelFunc) {
+		cancel()
+	})
+}
+
+// Starts a long poll that never observes a changed aggregation, ends it with `stop`,
+// and asserts that no waiter is left behind.
+func assertLongPollUnsubscribes(
+	t *testing.T,
+	env *leaseTestEnv,
+	stop func(cancel context.CancelFunc),
+) {
+	t.Helper()
+
+	ppr := env.waitForInformer(t)
+
+	keys := env.informer.Query(metav1.NamespaceDefault, labels.Set{"test": "true"})
+	require.Len(t, keys, 1)
+
+	ctx, cancelFunc := context.WithCancel(env.ctx)
+	defer cancelFunc()
+
+	done := make(chan bool, 1)
+
+	go func() {
+		changed, _, _ := env.api.WaitForAggregation(ctx, keys[0], ppr, env.clk.Now().Add(time.Second))
+		done <- changed
+	}()
+
+	require.Eventually(t, func() bool {
+		return env.clk.HasWaiters() && env.api.PprWaiterCount() == 1
+	}, time.Second*5, time.Millisecond*10)
+
+	stop(cancelFunc)
+
+	select {
+	case changed := <-done:
+		assert.False(t, changed)
+	case <-time.After(time.Second * 5):
+		require.FailNow(t, "long poll did not end")
+	}
+
+	assert.Equal(t, 0, env.api.PprWaiterCount(), "long poll should unsubscribe its waiter")
+}
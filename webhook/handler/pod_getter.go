@@ -0,0 +1,161 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"context"
+	"flag"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/kubewharf/podseidon/util/component"
+	"github.com/kubewharf/podseidon/util/errors"
+	utilflag "github.com/kubewharf/podseidon/util/flag"
+	"github.com/kubewharf/podseidon/util/kube"
+	"github.com/kubewharf/podseidon/util/util"
+)
+
+const PodGetterMuxName = "webhook-pod-getter"
+
+var RequestPodGetter = component.ProvideMux[PodGetter](
+	PodGetterMuxName,
+	"how to fetch the pod object for admission reviews that do not include the pod, e.g. pods/eviction",
+)
+
+// Fetches the pod under review from the cell it belongs to.
+type PodGetter interface {
+	GetPod(ctx context.Context, arg PodGetterArg) (*corev1.Pod, error)
+}
+
+type PodGetterArg struct {
+	CellId    string
+	Namespace string
+	Name      string
+}
+
+var DefaultPodGetterImpls = component.RequireDeps(
+	ClusterPodGetter(ClusterPodGetterArgs{ClusterName: "core"}, true),
+	ByCellPodGetter,
+)
+
+// Fetches pods from a fixed cluster regardless of the cell ID.
+var ClusterPodGetter = component.DeclareMuxImpl(
+	PodGetterMuxName,
+	func(args ClusterPodGetterArgs) string { return string(args.ClusterName) },
+	func(ClusterPodGetterArgs, *flag.FlagSet) util.Empty { return util.Empty{} },
+	func(args ClusterPodGetterArgs, reqs *component.DepRequests) ClusterPodGetterDeps {
+		return ClusterPodGetterDeps{
+			client: component.DepPtr(reqs, kube.NewClient(kube.ClientArgs{
+				ClusterName: args.ClusterName,
+			})),
+		}
+	},
+	func(context.Context, ClusterPodGetterArgs, util.Empty, ClusterPodGetterDeps) (*util.Empty, error) {
+		return &util.Empty{}, nil
+	},
+	component.Lifecycle[ClusterPodGetterArgs, util.Empty, ClusterPodGetterDeps, util.Empty]{
+		Start:        nil,
+		Join:         nil,
+		HealthChecks: nil,
+	},
+	func(d *component.Data[ClusterPodGetterArgs, util.Empty, ClusterPodGetterDeps, util.Empty]) PodGetter {
+		return clientsetPodGetter{clientSet: d.Deps.client.Get().NativeClientSet()}
+	},
+)
+
+type ClusterPodGetterArgs struct {
+	ClusterName kube.ClusterName
+}
+
+type ClusterPodGetterDeps struct {
+	client component.Dep[*kube.Client]
+}
+
+type clientsetPodGetter struct {
+	clientSet kubernetes.Interface
+}
+
+func (getter clientsetPodGetter) GetPod(ctx context.Context, arg PodGetterArg) (*corev1.Pod, error) {
+	pod, err := getter.clientSet.CoreV1().Pods(arg.Namespace).Get(ctx, arg.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.TagWrapf("GetPod", err, "get pod %s/%s from apiserver", arg.Namespace, arg.Name)
+	}
+
+	return pod, nil
+}
+
+// Fetches pods from the cluster of the cell, identified by a kubeconfig file for each cell.
+var ByCellPodGetter = component.DeclareMuxImpl(
+	PodGetterMuxName,
+	func(util.Empty) string { return "by-cell" },
+	func(_ util.Empty, fs *flag.FlagSet) ByCellPodGetterOptions {
+		return ByCellPodGetterOptions{
+			KubeconfigPaths: utilflag.Map(
+				fs,
+				"kubeconfig-paths",
+				map[string]string{},
+				"kubeconfig file paths for each cell, in the form cell1=path1,cell2=path2",
+				utilflag.StringParser,
+				utilflag.StringParser,
+			),
+		}
+	},
+	func(util.Empty, *component.DepRequests) util.Empty { return util.Empty{} },
+	func(_ context.Context, _ util.Empty, options ByCellPodGetterOptions, _ util.Empty) (*byCellPodGetter, error) {
+		getter := byCellPodGetter{}
+
+		for cellId, path := range *options.KubeconfigPaths {
+			restConfig, err := clientcmd.BuildConfigFromFlags("", path)
+			if err != nil {
+				return nil, errors.TagWrapf("BuildConfig", err, "build rest config for cell %q", cellId)
+			}
+
+			clientSet, err := kubernetes.NewForConfig(restConfig)
+			if err != nil {
+				return nil, errors.TagWrapf("NewClientSet", err, "create kubernetes client set for cell %q", cellId)
+			}
+
+			getter[cellId] = clientsetPodGetter{clientSet: clientSet}
+		}
+
+		return &getter, nil
+	},
+	component.Lifecycle[util.Empty, ByCellPodGetterOptions, util.Empty, byCellPodGetter]{
+		Start:        nil,
+		Join:         nil,
+		HealthChecks: nil,
+	},
+	func(d *component.Data[util.Empty, ByCellPodGetterOptions, util.Empty, byCellPodGetter]) PodGetter {
+		return *d.State
+	},
+)(util.Empty{}, false)
+
+type ByCellPodGetterOptions struct {
+	KubeconfigPaths *map[string]string
+}
+
+type byCellPodGetter map[string]clientsetPodGetter
+
+func (getter byCellPodGetter) GetPod(ctx context.Context, arg PodGetterArg) (*corev1.Pod, error) {
+	cellGetter, hasCell := getter[arg.CellId]
+	if !hasCell {
+		return nil, errors.TagErrorf("UnknownCell", "no kubeconfig is configured for cell %q", arg.CellId)
+	}
+
+	return cellGetter.GetPod(ctx, arg)
+}
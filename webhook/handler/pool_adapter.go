@@ -16,6 +16,7 @@ package handler
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -45,8 +46,10 @@ type PoolAdapter struct {
 	observer        observer.Observer
 	clock           clock.Clock
 	requiresPodName RequiresPodName
+	deleterIdentity DeleterIdentity
 	retryBackoff    func() time.Duration
 	defaultConfig   *defaultconfig.Options
+	leases          *leaseStore
 }
 
 func (PoolAdapter) PoolName() string {
@@ -117,17 +120,75 @@ func (adapter PoolAdapter) tryExecute(
 
 	executeTime := adapter.clock.Now()
 
-	pprutil.Summarize(config, ppr)
-	quota := pprutil.ComputeDisruptionQuota(ppr.Spec.MinAvailable, config, ppr.Status.Summary)
+	pprutil.PruneExpiredLeases(ppr, executeTime)
+
+	results := make([]pprutil.DisruptionResult, len(args))
+
+	// Rollbacks and lease returns are applied before computing the quota
+	// so that the released quota is immediately available to other pods in the same batch.
+	for argIndex, arg := range args {
+		if leaseReturn, isReturn := arg.ReturnLease.Get(); isReturn {
+			results[argIndex] = pprutil.DisruptionResultOk
+
+			adapter.returnLease(ppr, leaseReturn)
+
+			continue
+		}
+
+		if !arg.Rollback {
+			continue
+		}
+
+		results[argIndex] = pprutil.DisruptionResultOk
+
+		cellIndex := util.FindInSliceWith(
+			ppr.Status.Cells,
+			func(cell podseidonv1a1.PodProtectorCellStatus) bool { return cell.CellId == arg.CellId },
+		)
+		if cellIndex == -1 {
+			continue
+		}
+
+		// Buckets already compacted by aggregator no longer carry the pod UID and cannot be rolled back,
+		// but aggregator has already observed the pod in that case.
+		util.DrainSliceOrdered(
+			&ppr.Status.Cells[cellIndex].History.Buckets,
+			func(bucket podseidonv1a1.PodProtectorAdmissionBucket) bool {
+				return bucket.PodUid == nil || *bucket.PodUid != arg.PodUid
+			},
+		)
+	}
+
+	pprutil.Summarize(config, ppr, executeTime)
+
+	// Freeze and DryRun windows are handled before submitting to the pool;
+	// only the minAvailable override is relevant here.
+	minAvailable := pprutil.EffectiveMinAvailable(ppr, pprutil.ActiveWindow(ppr.Spec, executeTime))
+	quota := pprutil.ComputeDisruptionQuota(minAvailable, config, ppr.Status.Summary)
 
 	initialQuota := quota // value copy
 
-	results := make([]pprutil.DisruptionResult, len(args))
+	globalQuotas := []*pprutil.DisruptionQuota{&quota}
+	if rateQuota, hasRate := pprutil.ComputeDisruptionRateQuota(ppr, executeTime).Get(); hasRate {
+		globalQuotas = append(globalQuotas, &rateQuota)
+	}
+
+	// Computed lazily from the cell status before any buckets are appended in this batch.
+	cellQuotas := map[string]*pprutil.DisruptionQuota{}
+
+	acquireLease := false
 
 	for argIndex, arg := range args {
-		cellStatus := util.GetOrAppend(
+		if arg.Rollback || arg.ReturnLease.IsSome() {
+			continue
+		}
+
+		cellStatus := util.GetOrAppendSliceWith(
 			&ppr.Status.Cells,
 			func(cell *podseidonv1a1.PodProtectorCellStatus) bool { return cell.CellId == arg.CellId },
+			func() podseidonv1a1.PodProtectorCellStatus {
+				return podseidonv1a1.PodProtectorCellStatus{CellId: arg.CellId} //nolint:exhaustruct // new cell
+			},
 		)
 
 		duplicateBucket := util.FindInSliceWith(
@@ -145,32 +206,45 @@ func (adapter PoolAdapter) tryExecute(
 			continue
 		}
 
-		result := quota.Disrupt()
+		result := disruptInCell(ppr.Spec.CellConstraint, globalQuotas, cellQuotas, cellStatus)
 		results[argIndex] = result
 
 		if result == pprutil.DisruptionResultOk {
-			writePodName := ""
-			if adapter.requiresPodName.RequiresPodName(RequiresPodNameArg{CellId: arg.CellId, PodName: arg.PodName}) {
-				writePodName = arg.PodName
-			}
+			cellStatus.History.Buckets = append(cellStatus.History.Buckets, adapter.admissionBucket(observer.LeaseAdmission{
+				CellId:    arg.CellId,
+				PodUid:    arg.PodUid,
+				PodName:   arg.PodName,
+				Username:  arg.Username,
+				Operation: arg.Operation,
+				Time:      executeTime,
+			}))
+
+			acquireLease = acquireLease || arg.AcquireLease
+		}
+	}
 
-			cellStatus.History.Buckets = append(
-				cellStatus.History.Buckets,
-				podseidonv1a1.PodProtectorAdmissionBucket{
-					StartTime: metav1.MicroTime{Time: executeTime},
-					PodUid:    ptr.To(arg.PodUid),
-					PodName:   writePodName,
-				},
-			)
+	// Cell constraints and disruption rates depend on each admission, so leases are only granted from the global quota.
+	grantedLease := optional.None[podseidonv1a1.PodProtectorQuotaLease]()
+	if acquireLease && adapter.leases.enabled() && leaseEligible(ppr.Spec) && quota.Cleared > 0 {
+		lease := podseidonv1a1.PodProtectorQuotaLease{
+			Id:         adapter.leases.newLeaseId(),
+			Quota:      min(quota.Cleared, adapter.leases.size),
+			ExpiryTime: metav1.MicroTime{Time: executeTime.Add(adapter.leases.duration)},
 		}
+		quota.Cleared -= lease.Quota
+
+		ppr.Status.Leases = append(ppr.Status.Leases, lease)
+		grantedLease = optional.Some(lease)
 	}
 
 	adapter.observer.ExecuteRetryQuota(ctx, observer.ExecuteRetryQuota{
-		Before: initialQuota,
-		After:  quota,
+		Before:          initialQuota,
+		After:           quota,
+		StaleCells:      ppr.Status.Summary.StaleCells,
+		StaleCellPolicy: config.StaleCellPolicy,
 	})
 
-	pprutil.Summarize(config, ppr)
+	pprutil.Summarize(config, ppr, executeTime)
 
 	if !equality.Semantic.DeepEqual(originalPpr, ppr) {
 		if err := adapter.sourceProvider.UpdateStatus(ctx, key.SourceName, ppr); err != nil {
@@ -188,7 +262,96 @@ func (adapter PoolAdapter) tryExecute(
 		}
 	}
 
+	if lease, granted := grantedLease.Get(); granted {
+		adapter.leases.grant(key, lease, executeTime)
+
+		adapter.observer.QuotaLease(ctx, observer.QuotaLease{
+			Namespace: key.Namespace,
+			PprName:   key.Name,
+			LeaseId:   lease.Id,
+			Action:    observer.QuotaLeaseActionAcquire,
+			Quota:     lease.Quota,
+			Used:      0,
+			Err:       nil,
+		})
+	}
+
 	return retrybatch.ExecuteResultSuccess(
 		func(i int) pprutil.DisruptionResult { return results[i] },
 	)
 }
+
+// Removes the returned lease and appends the admission buckets of the deletions admitted against it.
+//
+// The buckets are appended even if the lease has expired,
+// since aggregator may not have observed the deletions yet.
+func (adapter PoolAdapter) returnLease(ppr *podseidonv1a1.PodProtector, leaseReturn observer.LeaseReturn) {
+	util.DrainSliceOrdered(&ppr.Status.Leases, func(lease podseidonv1a1.PodProtectorQuotaLease) bool {
+		return lease.Id != leaseReturn.LeaseId
+	})
+
+	for _, admission := range leaseReturn.Admissions {
+		cellStatus := util.GetOrAppendSliceWith(
+			&ppr.Status.Cells,
+			func(cell *podseidonv1a1.PodProtectorCellStatus) bool { return cell.CellId == admission.CellId },
+			func() podseidonv1a1.PodProtectorCellStatus {
+				return podseidonv1a1.PodProtectorCellStatus{CellId: admission.CellId} //nolint:exhaustruct // new cell
+			},
+		)
+
+		if util.FindInSliceWith(
+			cellStatus.History.Buckets,
+			func(bucket podseidonv1a1.PodProtectorAdmissionBucket) bool {
+				return bucket.PodUid != nil && *bucket.PodUid == admission.PodUid
+			},
+		) != -1 {
+			continue // already admitted through the pool
+		}
+
+		cellStatus.History.Buckets = append(cellStatus.History.Buckets, adapter.admissionBucket(admission))
+	}
+}
+
+func (adapter PoolAdapter) admissionBucket(admission observer.LeaseAdmission) podseidonv1a1.PodProtectorAdmissionBucket {
+	writePodName := ""
+	if adapter.requiresPodName.RequiresPodName(RequiresPodNameArg{CellId: admission.CellId, PodName: admission.PodName}) {
+		writePodName = admission.PodName
+	}
+
+	bucket := podseidonv1a1.PodProtectorAdmissionBucket{
+		StartTime: metav1.MicroTime{Time: admission.Time},
+		PodUid:    ptr.To(admission.PodUid),
+		PodName:   writePodName,
+	}
+
+	if identity := adapter.deleterIdentity.DeleterIdentity(DeleterIdentityArg{
+		CellId:   admission.CellId,
+		Username: admission.Username,
+	}); identity != "" {
+		bucket.User = identity
+		bucket.Operation = admission.Operation
+	}
+
+	return bucket
+}
+
+// Disrupts one replica in the cell, subject to all global quotas and the cell constraint if specified.
+func disruptInCell(
+	constraint *podseidonv1a1.PodProtectorCellConstraint,
+	globalQuotas []*pprutil.DisruptionQuota,
+	cellQuotas map[string]*pprutil.DisruptionQuota,
+	cell *podseidonv1a1.PodProtectorCellStatus,
+) pprutil.DisruptionResult {
+	if constraint == nil {
+		return pprutil.DisruptAll(globalQuotas...)
+	}
+
+	cellQuota, computed := cellQuotas[cell.CellId]
+	if !computed {
+		computedQuota := pprutil.ComputeCellDisruptionQuota(*constraint, cell)
+		cellQuota = &computedQuota
+		cellQuotas[cell.CellId] = cellQuota
+	}
+
+	return pprutil.DisruptAll(append(slices.Clone(globalQuotas), cellQuota)...)
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubewharf/podseidon/util/optional"
+	pprutil "github.com/kubewharf/podseidon/util/podprotector"
+)
+
+// Resolves the PodProtector used to choose the webhook replica that handles the review.
+//
+// If the pod matches multiple PodProtectors, the smallest key is used,
+// so that all replicas agree on the choice regardless of index order.
+// Returns None if the review should be handled by the receiving replica,
+// e.g. if it is not relevant, the pod cannot be resolved or no PodProtector matches.
+//
+// The resolved pod is also returned, so that it can be attached to the forwarded review
+// or passed to Handle if the review is processed locally,
+// and neither replica needs to fetch it again.
+func (api Api) RoutingKey(
+	ctx context.Context,
+	req *admissionv1.AdmissionRequest,
+	cellId string,
+) (optional.Optional[pprutil.PodProtectorKey], *corev1.Pod) {
+	if classifyRequest(req) == reviewKindNotRelevant || !api.state.informerHasSynced() {
+		return optional.None[pprutil.PodProtectorKey](), nil
+	}
+
+	subject, err := api.getSubject(ctx, req, cellId)
+	if err != nil {
+		// The error is reported when the review is handled locally.
+		return optional.None[pprutil.PodProtectorKey](), nil
+	}
+
+	pprRefs := api.pprInformer.Query(subject.Namespace, subject.Labels)
+	if len(pprRefs) == 0 {
+		return optional.None[pprutil.PodProtectorKey](), subject
+	}
+
+	return optional.Some(slices.MinFunc(pprRefs, func(left, right pprutil.PodProtectorKey) int {
+		return cmp.Or(
+			cmp.Compare(left.SourceName, right.SourceName),
+			cmp.Compare(left.Namespace, right.Namespace),
+			cmp.Compare(left.Name, right.Name),
+		)
+	})), subject
+}
+
+// The key of the PodProtector on the consistent hash ring of webhook replicas.
+func RingKey(key pprutil.PodProtectorKey) string {
+	return fmt.Sprintf("%s/%s/%s", key.SourceName, key.Namespace, key.Name)
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package handler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocktesting "k8s.io/utils/clock/testing"
+
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
+)
+
+func TestDryRunWindowWarnsRejection(t *testing.T) {
+	t.Parallel()
+
+	env := setupLeaseTest(t, func(clk *clocktesting.FakeClock, ppr *podseidonv1a1.PodProtector) {
+		ppr.Spec.MinAvailable = 10
+		ppr.Spec.Windows = []podseidonv1a1.PodProtectorWindow{
+			{
+				Name:   "maintenance",
+				Start:  &metav1.Time{Time: clk.Now().Add(-time.Hour)},
+				End:    &metav1.Time{Time: clk.Now().Add(time.Hour)},
+				Action: podseidonv1a1.PodProtectorWindowActionDryRun,
+			},
+		}
+	})
+
+	result := env.handle(t, "pod-0")
+	assert.False(t, result.Rejection.IsSome(), "DryRun windows never reject")
+
+	if assert.Len(t, result.Warnings, 1) {
+		assert.Contains(t, result.Warnings[0], `dry-run window "maintenance"`)
+		assert.Contains(t, result.Warnings[0], "too few available replicas")
+	}
+
+	assert.Empty(t, bucketPodUids(env.getPpr(t)), "DryRun windows do not reserve quota")
+}
@@ -18,13 +18,16 @@ import (
 	"github.com/kubewharf/podseidon/util/cmd"
 	"github.com/kubewharf/podseidon/util/component"
 	healthzobserver "github.com/kubewharf/podseidon/util/healthz/observer"
-	"github.com/kubewharf/podseidon/util/o11y/metrics"
+	httpobserver "github.com/kubewharf/podseidon/util/http/observer"
+	kubeobserver "github.com/kubewharf/podseidon/util/kube/observer"
+	metricshttp "github.com/kubewharf/podseidon/util/o11y/metrics/http"
 	pprutil "github.com/kubewharf/podseidon/util/podprotector"
 	pprutilobserver "github.com/kubewharf/podseidon/util/podprotector/observer"
 	"github.com/kubewharf/podseidon/util/pprof"
 	retrybatchobserver "github.com/kubewharf/podseidon/util/retrybatch/observer"
 	"github.com/kubewharf/podseidon/util/util"
 
+	"github.com/kubewharf/podseidon/webhook/certprovider"
 	"github.com/kubewharf/podseidon/webhook/handler"
 	webhookobserver "github.com/kubewharf/podseidon/webhook/observer"
 	"github.com/kubewharf/podseidon/webhook/server"
@@ -33,13 +36,18 @@ import (
 func main() {
 	cmd.Run(
 		component.RequireDep(pprof.New(util.Empty{})),
-		component.RequireDep(metrics.NewHttp(metrics.HttpArgs{})),
+		component.RequireDep(metricshttp.New(metricshttp.Args{})),
 		healthzobserver.Provide,
+		httpobserver.Provide,
+		kubeobserver.ProvideElector,
 		pprutilobserver.ProvideInformer,
 		webhookobserver.Provide,
 		retrybatchobserver.Provide,
 		component.RequireDep(server.New(server.Args{})),
 		pprutil.RequireSingleSourceProvider(pprutil.SingleSourceProviderArgs{ClusterName: "core"}, true),
 		handler.DefaultRequiresPodNameImpls,
+		handler.DefaultDeleterIdentityImpls,
+		handler.DefaultPodGetterImpls,
+		certprovider.DefaultImpls,
 	)
 }
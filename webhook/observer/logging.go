@@ -70,6 +70,7 @@ func ProvideLogging() component.Declared[Observer] {
 						"podName", arg.PodName,
 						"userName", arg.DeleteUserName,
 						"userGroups", strings.Join(arg.DeleteUserGroups, ","),
+						"exemptionRule", arg.ExemptionRule,
 					)
 					logger.V(2).WithCallDepth(1).Info("handle pod deletion for ppr start")
 					return klog.NewContext(ctx, logger), util.NoOp
@@ -139,8 +140,139 @@ func ProvideLogging() component.Declared[Observer] {
 						"quota.before.transitional", arg.Before.Transitional,
 						"quota.after.cleared", arg.After.Cleared,
 						"quota.after.transitional", arg.After.Transitional,
+						"staleCells", arg.StaleCells,
 					).V(4).WithCallDepth(1).Info("quota change")
 				},
+				HandlePodInGroup: func(ctx context.Context, arg HandlePodInGroup) {
+					logger := klog.FromContext(ctx).WithValues(
+						"namespace", arg.Namespace,
+						"group", arg.GroupName,
+						"ppr", arg.PprName,
+						"pod", arg.PodName,
+						"cell", arg.PodCell,
+						"rejected", arg.Rejected,
+					)
+
+					if arg.Err != nil {
+						logger.WithCallDepth(1).Error(arg.Err, "cannot handle pod in group")
+					} else {
+						logger.V(4).WithCallDepth(1).Info("handled pod in group")
+					}
+				},
+				LongPollRetry: func(ctx context.Context, arg LongPollRetry) {
+					logger := klog.FromContext(ctx).WithValues(
+						"namespace", arg.Namespace,
+						"ppr", arg.PprName,
+						"pod", arg.PodName,
+						"cell", arg.PodCell,
+						"waited", arg.Waited,
+						"attempts", arg.Attempts,
+						"admitted", arg.Admitted,
+					)
+
+					if arg.Err != nil {
+						logger.WithCallDepth(1).Error(arg.Err, "long-poll retry failed")
+					} else {
+						logger.V(4).WithCallDepth(1).Info("long-polled retry")
+					}
+				},
+				DeadlineExceeded: func(ctx context.Context, arg DeadlineExceeded) {
+					klog.FromContext(ctx).WithCallDepth(1).Info(
+						"admission deadline exceeded",
+						"namespace", arg.Namespace,
+						"ppr", arg.PprName,
+						"group", arg.GroupName,
+						"pod", arg.PodName,
+						"cell", arg.PodCell,
+						"verdict", arg.Verdict,
+						"err", arg.Err,
+					)
+				},
+				QuotaLease: func(ctx context.Context, arg QuotaLease) {
+					logger := klog.FromContext(ctx).WithValues(
+						"namespace", arg.Namespace,
+						"ppr", arg.PprName,
+						"lease", arg.LeaseId,
+						"action", arg.Action,
+						"quota", arg.Quota,
+						"used", arg.Used,
+					)
+
+					if arg.Err != nil {
+						logger.WithCallDepth(1).Error(arg.Err, "quota lease failed")
+					} else {
+						logger.V(4).WithCallDepth(1).Info("quota lease")
+					}
+				},
+				ForwardReview: func(ctx context.Context, arg ForwardReview) {
+					logger := klog.FromContext(ctx).WithValues(
+						"namespace", arg.Namespace,
+						"ppr", arg.PprName,
+						"cell", arg.Cell,
+						"peer", arg.Peer,
+						"latency", arg.Latency,
+					)
+
+					if arg.Err != nil {
+						logger.WithCallDepth(1).Error(arg.Err, "cannot forward review to peer, processing locally")
+					} else {
+						logger.V(4).WithCallDepth(1).Info("forwarded review to peer")
+					}
+				},
+				UpdatePeers: func(ctx context.Context, arg UpdatePeers) {
+					klog.FromContext(ctx).WithCallDepth(1).Info("updated webhook peers", "peers", arg.Peers)
+				},
+				RollbackReservation: func(ctx context.Context, arg RollbackReservation) {
+					logger := klog.FromContext(ctx).WithValues(
+						"namespace", arg.Namespace,
+						"ppr", arg.PprName,
+						"group", arg.GroupName,
+						"pod", arg.PodName,
+						"cell", arg.PodCell,
+					)
+
+					if arg.Err != nil {
+						logger.WithCallDepth(1).Error(arg.Err, "cannot roll back reservation")
+					} else {
+						logger.V(3).WithCallDepth(1).Info("rolled back reservation")
+					}
+				},
+				SyncSelfManagedCert: func(ctx context.Context, arg SyncSelfManagedCert) {
+					logger := klog.FromContext(ctx).WithValues(
+						"caRotated", arg.CaRotated,
+						"servingRotated", arg.ServingRotated,
+						"caBundlePatched", arg.CaBundlePatched,
+					)
+
+					switch {
+					case arg.Err != nil:
+						logger.WithCallDepth(1).Error(arg.Err, "cannot sync self-managed webhook certificate")
+					case arg.CaRotated || arg.ServingRotated || arg.CaBundlePatched:
+						logger.WithCallDepth(1).Info("updated self-managed webhook certificate")
+					default:
+						logger.V(4).WithCallDepth(1).Info("self-managed webhook certificate is up to date")
+					}
+				},
+				ReloadExemptionRules: func(ctx context.Context, arg ReloadExemptionRules) {
+					logger := klog.FromContext(ctx).WithValues("path", arg.Path)
+
+					if arg.Err != nil {
+						logger.WithCallDepth(1).Error(arg.Err, "cannot reload exemption rules, previous rules remain effective")
+					} else {
+						logger.WithCallDepth(1).Info("reloaded exemption rules", "ruleCount", arg.RuleCount)
+					}
+				},
+				ExemptPod: func(ctx context.Context, arg ExemptPod) {
+					klog.FromContext(ctx).V(2).WithCallDepth(1).Info(
+						"admitted pod by exemption rule",
+						"namespace", arg.Namespace,
+						"pod", arg.PodName,
+						"cell", arg.PodCell,
+						"user", arg.DeleteUserName,
+						"groups", arg.DeleteUserGroups,
+						"exemptionRule", arg.ExemptionRule,
+					)
+				},
 			}
 		},
 	)
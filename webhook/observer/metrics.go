@@ -68,6 +68,7 @@ func ProvideMetrics() component.Declared[Observer] {
 					pprName   string
 					podName   string
 					podCell   string
+					exemption string
 				}
 			)
 
@@ -82,6 +83,8 @@ func ProvideMetrics() component.Declared[Observer] {
 				Namespace string
 				// The username deleting the pod, when by-user is enabled.
 				User string
+				// The exemption rule matched by the request, if any.
+				ExemptionRule string
 
 				PodInPprBaseTags
 			}
@@ -110,6 +113,146 @@ func ProvideMetrics() component.Declared[Observer] {
 				metrics.NewReflectTags[podInPprTags](),
 			)
 
+			type podInGroupTags struct {
+				PodCell  string
+				Rejected bool
+				Error    string
+			}
+
+			podInGroupHandle := metrics.Register(
+				deps.Registry(),
+				"webhook_handle_pod_in_group",
+				"Number of pods handled in each matched PodProtectorGroup.",
+				metrics.IntCounter(),
+				metrics.NewReflectTags[podInGroupTags](),
+			)
+
+			type longPollTags struct {
+				PodCell  string
+				Admitted bool
+				Error    string
+			}
+
+			longPollHandle := metrics.Register(
+				deps.Registry(),
+				"webhook_long_poll_retry",
+				"Time spent waiting for newer PodProtector aggregation before retrying a RetryAdvised result.",
+				metrics.AsyncLatencyDurationHistogram(),
+				metrics.NewReflectTags[longPollTags](),
+			)
+
+			type staleCellsTags struct {
+				Policy string
+			}
+
+			staleCellsHandle := metrics.Register(
+				deps.Registry(),
+				"webhook_stale_cells",
+				"Number of stale cells counted conservatively in PodProtector quota computation, summed over batch executions.",
+				metrics.IntCounter(),
+				metrics.NewReflectTags[staleCellsTags](),
+			)
+
+			type deadlineExceededTags struct {
+				PodCell string
+				Verdict string
+			}
+
+			deadlineExceededHandle := metrics.Register(
+				deps.Registry(),
+				"webhook_deadline_exceeded",
+				"Number of requests where the admission deadline expired before quota could be reserved.",
+				metrics.IntCounter(),
+				metrics.NewReflectTags[deadlineExceededTags](),
+			)
+
+			type quotaLeaseTags struct {
+				Action string
+				Error  string
+			}
+
+			quotaLeaseHandle := metrics.Register(
+				deps.Registry(),
+				"webhook_quota_lease",
+				"Number of deletions reserved by acquired quota leases and admitted against returned quota leases.",
+				metrics.IntCounter(),
+				metrics.NewReflectTags[quotaLeaseTags](),
+			)
+
+			type forwardTags struct {
+				PodCell string
+				Error   string
+			}
+
+			forwardHandle := metrics.Register(
+				deps.Registry(),
+				"webhook_peer_forward",
+				"Time spent forwarding admission reviews to the webhook replica owning the PodProtector.",
+				metrics.AsyncLatencyDurationHistogram(),
+				metrics.NewReflectTags[forwardTags](),
+			)
+
+			peersHandle := metrics.Register(
+				deps.Registry(),
+				"webhook_peers",
+				"Number of webhook replicas on the consistent hash ring.",
+				metrics.IntGauge(),
+				metrics.NewReflectTags[util.Empty](),
+			)
+
+			type rollbackTags struct {
+				PodCell string
+				Error   string
+			}
+
+			rollbackHandle := metrics.Register(
+				deps.Registry(),
+				"webhook_rollback_reservation",
+				"Number of reservations rolled back because another PodProtector or PodProtectorGroup rejected the pod.",
+				metrics.IntCounter(),
+				metrics.NewReflectTags[rollbackTags](),
+			)
+
+			type selfManagedCertTags struct {
+				CaRotated       bool
+				ServingRotated  bool
+				CaBundlePatched bool
+				Error           string
+			}
+
+			selfManagedCertHandle := metrics.Register(
+				deps.Registry(),
+				"webhook_self_managed_cert_sync",
+				"Number of leader syncs of self-managed webhook certificates.",
+				metrics.IntCounter(),
+				metrics.NewReflectTags[selfManagedCertTags](),
+			)
+
+			type exemptionReloadTags struct {
+				Error string
+			}
+
+			exemptionReloadHandle := metrics.Register(
+				deps.Registry(),
+				"webhook_exemption_rules_reload",
+				"Number of changes to the exemption rules file.",
+				metrics.IntCounter(),
+				metrics.NewReflectTags[exemptionReloadTags](),
+			)
+
+			type exemptPodTags struct {
+				PodCell       string
+				ExemptionRule string
+			}
+
+			exemptPodHandle := metrics.Register(
+				deps.Registry(),
+				"webhook_exempt_pod",
+				"Number of requests admitted by AlwaysAllow exemption rules.",
+				metrics.IntCounter(),
+				metrics.NewReflectTags[exemptPodTags](),
+			)
+
 			handleUniquePprHandles := make([]metrics.Handle[PodInPprBaseTags, string], 0, len(UniqueRejectRateWindows))
 			handleUniquePodHandles := make([]metrics.Handle[PodInPprBaseTags, string], 0, len(UniqueRejectRateWindows))
 
@@ -182,6 +325,7 @@ func ProvideMetrics() component.Declared[Observer] {
 							pprName:   arg.PprName,
 							podName:   arg.PodName,
 							podCell:   arg.PodCell,
+							exemption: arg.ExemptionRule,
 						},
 					), util.NoOp
 				},
@@ -192,6 +336,9 @@ func ProvideMetrics() component.Declared[Observer] {
 						PodCell:   ctxValue.podCell,
 						Namespace: ctxValue.namespace,
 						User:      ctxValue.user,
+
+						ExemptionRule: ctxValue.exemption,
+
 						PodInPprBaseTags: PodInPprBaseTags{
 							Rejected: arg.Rejected,
 						},
@@ -213,7 +360,71 @@ func ProvideMetrics() component.Declared[Observer] {
 				EndExecuteRetrySuccess: func(context.Context, EndExecuteRetrySuccess) {},
 				EndExecuteRetryRetry:   func(context.Context, EndExecuteRetryRetry) {},
 				EndExecuteRetryErr:     func(context.Context, EndExecuteRetryErr) {},
-				ExecuteRetryQuota:      func(context.Context, ExecuteRetryQuota) {},
+				ExecuteRetryQuota: func(_ context.Context, arg ExecuteRetryQuota) {
+					if arg.StaleCells > 0 {
+						staleCellsHandle.Emit(int(arg.StaleCells), staleCellsTags{Policy: string(arg.StaleCellPolicy)})
+					}
+				},
+				HandlePodInGroup: func(_ context.Context, arg HandlePodInGroup) {
+					podInGroupHandle.Emit(1, podInGroupTags{
+						PodCell:  arg.PodCell,
+						Rejected: arg.Rejected,
+						Error:    errors.SerializeTags(arg.Err),
+					})
+				},
+				LongPollRetry: func(_ context.Context, arg LongPollRetry) {
+					longPollHandle.Emit(arg.Waited, longPollTags{
+						PodCell:  arg.PodCell,
+						Admitted: arg.Admitted,
+						Error:    errors.SerializeTags(arg.Err),
+					})
+				},
+				DeadlineExceeded: func(_ context.Context, arg DeadlineExceeded) {
+					deadlineExceededHandle.Emit(1, deadlineExceededTags{
+						PodCell: arg.PodCell,
+						Verdict: arg.Verdict,
+					})
+				},
+				QuotaLease: func(_ context.Context, arg QuotaLease) {
+					count := arg.Quota
+					if arg.Action == QuotaLeaseActionReturn {
+						count = arg.Used
+					}
+
+					quotaLeaseHandle.Emit(int(count), quotaLeaseTags{
+						Action: string(arg.Action),
+						Error:  errors.SerializeTags(arg.Err),
+					})
+				},
+				ForwardReview: func(_ context.Context, arg ForwardReview) {
+					forwardHandle.Emit(arg.Latency, forwardTags{
+						PodCell: arg.Cell,
+						Error:   errors.SerializeTags(arg.Err),
+					})
+				},
+				UpdatePeers: func(_ context.Context, arg UpdatePeers) {
+					peersHandle.Emit(len(arg.Peers), util.Empty{})
+				},
+				RollbackReservation: func(_ context.Context, arg RollbackReservation) {
+					rollbackHandle.Emit(1, rollbackTags{
+						PodCell: arg.PodCell,
+						Error:   errors.SerializeTags(arg.Err),
+					})
+				},
+				SyncSelfManagedCert: func(_ context.Context, arg SyncSelfManagedCert) {
+					selfManagedCertHandle.Emit(1, selfManagedCertTags{
+						CaRotated:       arg.CaRotated,
+						ServingRotated:  arg.ServingRotated,
+						CaBundlePatched: arg.CaBundlePatched,
+						Error:           errors.SerializeTags(arg.Err),
+					})
+				},
+				ReloadExemptionRules: func(_ context.Context, arg ReloadExemptionRules) {
+					exemptionReloadHandle.Emit(1, exemptionReloadTags{Error: errors.SerializeTags(arg.Err)})
+				},
+				ExemptPod: func(_ context.Context, arg ExemptPod) {
+					exemptPodHandle.Emit(1, exemptPodTags{PodCell: arg.PodCell, ExemptionRule: arg.ExemptionRule})
+				},
 			}
 		},
 	)
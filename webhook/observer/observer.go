@@ -20,8 +20,11 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/types"
 
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
+
 	"github.com/kubewharf/podseidon/util/component"
 	"github.com/kubewharf/podseidon/util/o11y"
+	"github.com/kubewharf/podseidon/util/optional"
 	pprutil "github.com/kubewharf/podseidon/util/podprotector"
 )
 
@@ -43,6 +46,24 @@ type Observer struct {
 	EndExecuteRetryRetry   o11y.ObserveFunc[EndExecuteRetryRetry]
 	EndExecuteRetryErr     o11y.ObserveFunc[EndExecuteRetryErr]
 	ExecuteRetryQuota      o11y.ObserveFunc[ExecuteRetryQuota]
+
+	HandlePodInGroup o11y.ObserveFunc[HandlePodInGroup]
+
+	LongPollRetry o11y.ObserveFunc[LongPollRetry]
+
+	DeadlineExceeded o11y.ObserveFunc[DeadlineExceeded]
+
+	QuotaLease o11y.ObserveFunc[QuotaLease]
+
+	ForwardReview o11y.ObserveFunc[ForwardReview]
+	UpdatePeers   o11y.ObserveFunc[UpdatePeers]
+
+	RollbackReservation o11y.ObserveFunc[RollbackReservation]
+
+	SyncSelfManagedCert o11y.ObserveFunc[SyncSelfManagedCert]
+
+	ReloadExemptionRules o11y.ObserveFunc[ReloadExemptionRules]
+	ExemptPod            o11y.ObserveFunc[ExemptPod]
 }
 
 func (Observer) ComponentName() string { return "webhook" }
@@ -62,15 +83,22 @@ type RequestComplete struct {
 type RequestStatus string
 
 const (
-	RequestStatusUnmatched          = RequestStatus("Unmatched")
-	RequestStatusNotRelevant        = RequestStatus("NotRelevant")
-	RequestStatusAlreadyTerminating = RequestStatus("AlreadyTerminating")
-	RequestStatusAlreadyUnready     = RequestStatus("AlreadyUnready")
-	RequestStatusStillUnavailable   = RequestStatus("StillUnavailable")
-	RequestStatusAdmittedAll        = RequestStatus("AdmittedAll")
-	RequestStatusRetryAdvised       = RequestStatus("RetryAdvised")
-	RequestStatusRejected           = RequestStatus("Rejected")
-	RequestStatusError              = RequestStatus("Error")
+	RequestStatusUnmatched            = RequestStatus("Unmatched")
+	RequestStatusNotRelevant          = RequestStatus("NotRelevant")
+	RequestStatusPodNotFound          = RequestStatus("PodNotFound")
+	RequestStatusPreconditionMismatch = RequestStatus("PreconditionMismatch")
+	RequestStatusDryRun               = RequestStatus("DryRun")
+	RequestStatusDryRunRejected       = RequestStatus("DryRunRejected")
+	RequestStatusExempted             = RequestStatus("Exempted")
+	RequestStatusAlreadyTerminating   = RequestStatus("AlreadyTerminating")
+	RequestStatusAlreadyUnready       = RequestStatus("AlreadyUnready")
+	RequestStatusStillUnavailable     = RequestStatus("StillUnavailable")
+	RequestStatusAdmittedAll          = RequestStatus("AdmittedAll")
+	RequestStatusRetryAdvised         = RequestStatus("RetryAdvised")
+	RequestStatusRejected             = RequestStatus("Rejected")
+	RequestStatusFrozen               = RequestStatus("Frozen")
+	RequestStatusDeadlineExceeded     = RequestStatus("DeadlineExceeded")
+	RequestStatusError                = RequestStatus("Error")
 )
 
 type HttpError struct {
@@ -85,6 +113,9 @@ type StartHandlePodInPpr struct {
 
 	DeleteUserName   string
 	DeleteUserGroups []string
+
+	// Name of the exemption rule matched by the request, empty if none.
+	ExemptionRule string
 }
 
 type EndHandlePodInPpr struct {
@@ -103,6 +134,38 @@ type BatchArg struct {
 	CellId  string
 	PodUid  types.UID
 	PodName string
+
+	// The username of the deleting user, recorded subject to the DeleterIdentity plugin.
+	Username  string
+	Operation podseidonv1a1.PodProtectorAdmissionOperation
+
+	// Removes the admission bucket of the pod instead of reserving a new one.
+	Rollback bool
+
+	// Reserves a quota lease for the webhook replica in the same write if the pod is admitted.
+	AcquireLease bool
+	// Returns a quota lease instead of reserving admission for a pod.
+	// Pod-specific fields are unused if this is set.
+	ReturnLease optional.Optional[LeaseReturn]
+}
+
+// Removes a quota lease from the PodProtector,
+// recording the deletions admitted against it as admission buckets.
+type LeaseReturn struct {
+	LeaseId    string
+	Admissions []LeaseAdmission
+}
+
+// A deletion admitted locally against a quota lease.
+type LeaseAdmission struct {
+	CellId    string
+	PodUid    types.UID
+	PodName   string
+	Username  string
+	Operation podseidonv1a1.PodProtectorAdmissionOperation
+
+	// The time at which the deletion was admitted, used as the start time of the admission bucket.
+	Time time.Time
 }
 
 type EndExecuteRetrySuccess struct {
@@ -120,4 +183,136 @@ type EndExecuteRetryErr struct {
 type ExecuteRetryQuota struct {
 	Before pprutil.DisruptionQuota
 	After  pprutil.DisruptionQuota
+
+	// Number of cells counted with the stale cell policy when computing the quota.
+	StaleCells      int32
+	StaleCellPolicy podseidonv1a1.StaleCellPolicy
+}
+
+type HandlePodInGroup struct {
+	Namespace string
+	GroupName string
+	// The member PodProtector through which the pod is reserved in the group.
+	PprName string
+	PodName string
+	PodCell string
+
+	Rejected bool
+	Err      error
+}
+
+// A RetryAdvised result was long-polled until the PodProtector aggregation changed.
+type LongPollRetry struct {
+	Namespace string
+	PprName   string
+	PodName   string
+	PodCell   string
+
+	// Total time spent waiting for newer aggregation.
+	Waited time.Duration
+	// Number of times the quota check was re-run.
+	Attempts int
+	// Whether the pod was eventually admitted.
+	Admitted bool
+	Err      error
+}
+
+// A request admitted by an AlwaysAllow exemption rule without evaluating any PodProtector.
+type ExemptPod struct {
+	Namespace string
+	PodName   string
+	PodCell   string
+
+	DeleteUserName   string
+	DeleteUserGroups []string
+
+	ExemptionRule string
+}
+
+// The admission deadline expired before the quota of a PodProtector or PodProtectorGroup could be reserved.
+type DeadlineExceeded struct {
+	Namespace string
+	// Name of the PodProtector, or empty if the deadline expired in a PodProtectorGroup.
+	PprName string
+	// Name of the PodProtectorGroup, or empty if the deadline expired in a PodProtector.
+	GroupName string
+	PodName   string
+	PodCell   string
+
+	// Whether the request is denied or advised to retry.
+	Verdict string
+	Err     error
+}
+
+// A quota lease was reserved or returned by this webhook replica.
+type QuotaLease struct {
+	Namespace string
+	PprName   string
+	LeaseId   string
+
+	Action QuotaLeaseAction
+	// Number of deletions reserved by the lease.
+	Quota int32
+	// Number of deletions admitted against the lease, only known when the lease is returned.
+	Used int32
+	Err  error
+}
+
+type QuotaLeaseAction string
+
+const (
+	QuotaLeaseActionAcquire = QuotaLeaseAction("Acquire")
+	QuotaLeaseActionReturn  = QuotaLeaseAction("Return")
+)
+
+// An admission review was forwarded to the webhook replica owning its PodProtector.
+type ForwardReview struct {
+	Namespace string
+	PprName   string
+	Cell      string
+	// Name of the peer replica.
+	Peer    string
+	Latency time.Duration
+	// If non-nil, the review is processed locally instead.
+	Err error
+}
+
+// The set of webhook replicas on the consistent hash ring changed.
+type UpdatePeers struct {
+	Peers []string
+}
+
+// Argument for webhook group retry-batch-pool.
+type GroupBatchArg struct {
+	// The member PodProtector through which the pod is reserved in the group.
+	PprName string
+	CellId  string
+	PodUid  types.UID
+	PodName string
+
+	// Removes the group admission of the pod instead of reserving a new one.
+	Rollback bool
+}
+
+type RollbackReservation struct {
+	Namespace string
+	PprName   string
+	// Set instead of PprName if the rolled back reservation is in a PodProtectorGroup.
+	GroupName string
+	PodName   string
+	PodCell   string
+	Err       error
+}
+
+type SyncSelfManagedCert struct {
+	CaRotated       bool
+	ServingRotated  bool
+	CaBundlePatched bool
+	Err             error
+}
+
+type ReloadExemptionRules struct {
+	Path      string
+	RuleCount int
+	Err       error
 }
@@ -0,0 +1,469 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Routes admission reviews between webhook replicas,
+// so that concurrent reviews of the same PodProtector are batched by the same retrybatch.Pool.
+package peer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	kubeinformers "k8s.io/client-go/informers"
+	discoveryv1listers "k8s.io/client-go/listers/discovery/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubewharf/podseidon/util/component"
+	"github.com/kubewharf/podseidon/util/errors"
+	utilflag "github.com/kubewharf/podseidon/util/flag"
+	"github.com/kubewharf/podseidon/util/hashring"
+	"github.com/kubewharf/podseidon/util/kube"
+	"github.com/kubewharf/podseidon/util/o11y"
+	"github.com/kubewharf/podseidon/util/optional"
+	"github.com/kubewharf/podseidon/util/util"
+
+	"github.com/kubewharf/podseidon/webhook/observer"
+)
+
+// Set on forwarded reviews so that the receiving replica processes them locally.
+const ForwardedByHeader = "X-Podseidon-Forwarded-By"
+
+const caReloadInterval = time.Second * 10
+
+// Maximum fraction of the remaining request time spent waiting for a peer.
+const forwardDeadlineFraction = 0.5
+
+var New = component.Declare(
+	func(Args) string { return "webhook-peer" },
+	func(_ Args, fs *flag.FlagSet) Options {
+		return Options{
+			Enable: fs.Bool(
+				"enable",
+				false,
+				"forward admission reviews to the webhook replica owning the PodProtector on a consistent hash ring",
+			),
+			Identity: fs.String(
+				"identity",
+				"",
+				"name of the pod running this replica as referenced by the EndpointSlices, defaults to the hostname",
+			),
+			ServiceNamespace: fs.String(
+				"service-namespace",
+				metav1.NamespaceDefault,
+				"namespace of the webhook Service",
+			),
+			ServiceName: fs.String(
+				"service-name",
+				"podseidon-webhook",
+				"name of the webhook Service whose EndpointSlices list the peer replicas",
+			),
+			PortName: fs.String(
+				"port-name",
+				"webhook",
+				"name of the EndpointSlice port to forward reviews to",
+			),
+			Scheme: utilflag.EnumFromMap(map[string]string{"http": "http", "https": "https"}).
+				TypeName("scheme").
+				Default("https").
+				Flag(fs, "scheme", "scheme used to connect to peer replicas"),
+			CaFile: fs.String(
+				"ca-file",
+				"",
+				"PEM bundle trusted for peer serving certificates, reloaded periodically; system roots are used if empty",
+			),
+			TlsServerName: fs.String(
+				"tls-server-name",
+				"",
+				"server name verified in peer serving certificates, e.g. the DNS name of the webhook Service",
+			),
+			VirtualNodes: fs.Int(
+				"virtual-nodes",
+				64,
+				"number of points per replica on the consistent hash ring",
+			),
+			DialTimeout: fs.Duration(
+				"dial-timeout",
+				time.Second,
+				"timeout for connecting to a peer replica, including the TLS handshake",
+			),
+			ResponseTimeout: fs.Duration(
+				"response-timeout",
+				time.Second*5,
+				"timeout for a peer replica to respond to a forwarded review, "+
+					"capped at half of the remaining request time",
+			),
+		}
+	},
+	func(_ Args, requests *component.DepRequests) Deps {
+		return Deps{
+			client:   component.DepPtr(requests, kube.NewClient(kube.ClientArgs{ClusterName: "core"})),
+			observer: o11y.Request[observer.Observer](requests),
+		}
+	},
+	func(ctx context.Context, args Args, options Options, deps Deps) (*State, error) {
+		router := &Router{
+			enabled:         *options.Enable,
+			identity:        *options.Identity,
+			portName:        *options.PortName,
+			scheme:          *options.Scheme,
+			virtualNodes:    *options.VirtualNodes,
+			responseTimeout: *options.ResponseTimeout,
+			observer:        deps.observer.Get(),
+			table:           atomic.Pointer[routingTable]{},
+			client: &peerClient{
+				clk:         args.Clock,
+				caFile:      *options.CaFile,
+				serverName:  *options.TlsServerName,
+				dialTimeout: *options.DialTimeout,
+				mu:          sync.Mutex{},
+				caPem:       nil,
+				loadTime:    time.Time{},
+				client:      nil,
+			},
+		}
+		router.table.Store(&routingTable{ring: hashring.New(nil, 0), addresses: map[string]string{}})
+
+		if !router.enabled {
+			return &State{router: router, factory: optional.None[kubeinformers.SharedInformerFactory]()}, nil
+		}
+
+		if router.identity == "" {
+			hostname, err := os.Hostname()
+			if err != nil {
+				return nil, errors.TagWrapf("GetHostname", err, "get hostname")
+			}
+
+			router.identity = hostname
+		}
+
+		factory := kubeinformers.NewSharedInformerFactoryWithOptions(
+			deps.client.Get().NativeClientSet(),
+			0,
+			kubeinformers.WithNamespace(*options.ServiceNamespace),
+			kubeinformers.WithTweakListOptions(func(listOptions *metav1.ListOptions) {
+				listOptions.LabelSelector = labels.SelectorFromSet(labels.Set{
+					discoveryv1.LabelServiceName: *options.ServiceName,
+				}).String()
+			}),
+		)
+
+		sliceInformer := factory.Discovery().V1().EndpointSlices()
+		lister := sliceInformer.Lister()
+
+		onChange := func(any) { router.rebuild(ctx, lister) }
+
+		if _, err := sliceInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    onChange,
+			UpdateFunc: func(_, newObj any) { onChange(newObj) },
+			DeleteFunc: onChange,
+		}); err != nil {
+			return nil, errors.TagWrapf("AddEndpointSliceEventHandler", err, "add event handler to EndpointSlice informer")
+		}
+
+		return &State{router: router, factory: optional.Some(factory)}, nil
+	},
+	component.Lifecycle[Args, Options, Deps, State]{
+		Start: func(ctx context.Context, _ *Args, _ *Options, _ *Deps, state *State) error {
+			if factory, enabled := state.factory.Get(); enabled {
+				factory.Start(ctx.Done())
+			}
+
+			return nil
+		},
+		Join: func(_ context.Context, _ *Args, _ *Options, _ *Deps, state *State) error {
+			if factory, enabled := state.factory.Get(); enabled {
+				factory.Shutdown()
+			}
+
+			return nil
+		},
+		HealthChecks: nil,
+	},
+	func(d *component.Data[Args, Options, Deps, State]) *Router { return d.State.router },
+)
+
+type Args struct {
+	Clock clock.Clock
+}
+
+type Options struct {
+	Enable           *bool
+	Identity         *string
+	ServiceNamespace *string
+	ServiceName      *string
+	PortName         *string
+	Scheme           *string
+	CaFile           *string
+	TlsServerName    *string
+	VirtualNodes     *int
+	DialTimeout      *time.Duration
+	ResponseTimeout  *time.Duration
+}
+
+type Deps struct {
+	client   component.Dep[*kube.Client]
+	observer component.Dep[observer.Observer]
+}
+
+type State struct {
+	router  *Router
+	factory optional.Optional[kubeinformers.SharedInformerFactory]
+}
+
+// Assigns PodProtectors to webhook replicas and forwards reviews to them.
+type Router struct {
+	enabled         bool
+	identity        string
+	portName        string
+	scheme          string
+	virtualNodes    int
+	responseTimeout time.Duration
+
+	observer observer.Observer
+	table    atomic.Pointer[routingTable]
+	client   *peerClient
+}
+
+type routingTable struct {
+	ring *hashring.Ring
+	// Address (host:port) of each ring member.
+	addresses map[string]string
+}
+
+// A webhook replica other than this one.
+type Peer struct {
+	Name    string
+	Address string
+}
+
+// Whether reviews may be forwarded to peers.
+func (router *Router) Enabled() bool {
+	return router.enabled
+}
+
+// Returns the peer owning the key, or None if this replica owns the key or peer routing is disabled.
+//
+// This replica owns all keys until it has discovered its peers.
+func (router *Router) Owner(key string) optional.Optional[Peer] {
+	if !router.enabled {
+		return optional.None[Peer]()
+	}
+
+	table := router.table.Load()
+
+	owner, hasOwner := table.ring.Owner(key).Get()
+	if !hasOwner || owner == router.identity {
+		return optional.None[Peer]()
+	}
+
+	return optional.Some(Peer{Name: owner, Address: table.addresses[owner]})
+}
+
+// Forwards an admission review request body to the peer and returns the response body.
+//
+// `path` is the request path on the peer, e.g. `/webhook/cell-1`.
+// The wait is bounded by the response timeout, capped at a fraction of the time remaining until the deadline of ctx,
+// so that the review can still be processed locally if the peer is unresponsive.
+// The bound is passed to the peer as the `timeout` query parameter.
+// An error is returned if the peer cannot be reached or does not respond with 200 OK in time,
+// in which case the caller should process the review locally.
+func (router *Router) Forward(ctx context.Context, peer Peer, path string, body []byte) ([]byte, error) {
+	client, err := router.client.get()
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := router.responseTimeout
+	if deadline, hasDeadline := ctx.Deadline(); hasDeadline {
+		timeout = min(timeout, time.Duration(float64(time.Until(deadline))*forwardDeadlineFraction))
+	}
+
+	ctx, cancelFunc := context.WithTimeout(ctx, timeout)
+	defer cancelFunc()
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		fmt.Sprintf(
+			"%s://%s%s?%s",
+			router.scheme, peer.Address, path,
+			url.Values{"timeout": []string{timeout.String()}}.Encode(),
+		),
+		bytes.NewReader(body),
+	)
+	if err != nil {
+		return nil, errors.TagWrapf("NewForwardRequest", err, "create forward request")
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(ForwardedByHeader, router.identity)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errors.TagWrapf("ForwardRequest", err, "forward review to peer %s", peer.Name)
+	}
+
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.TagWrapf("ReadForwardResponse", err, "read response from peer %s", peer.Name)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.TagErrorf(
+			"ForwardResponseStatus",
+			"peer %s responded with HTTP %d: %s",
+			peer.Name, resp.StatusCode, string(respBody),
+		)
+	}
+
+	return respBody, nil
+}
+
+// Recomputes the ring from the ready endpoints of the webhook Service.
+func (router *Router) rebuild(ctx context.Context, lister discoveryv1listers.EndpointSliceLister) {
+	endpointSlices, err := lister.List(labels.Everything())
+	if err != nil {
+		// listing from the indexer never fails
+		return
+	}
+
+	addresses := map[string]string{}
+
+	for _, slice := range endpointSlices {
+		portIndex := util.FindInSliceWith(
+			slice.Ports,
+			func(port discoveryv1.EndpointPort) bool { return ptr.Deref(port.Name, "") == router.portName },
+		)
+		if portIndex == -1 || slice.Ports[portIndex].Port == nil {
+			continue
+		}
+
+		port := strconv.Itoa(int(*slice.Ports[portIndex].Port))
+
+		for _, endpoint := range slice.Endpoints {
+			// Unknown readiness is interpreted as ready, following the EndpointSlice API.
+			if !ptr.Deref(endpoint.Conditions.Ready, true) ||
+				endpoint.TargetRef == nil || endpoint.TargetRef.Kind != "Pod" ||
+				len(endpoint.Addresses) == 0 {
+				continue
+			}
+
+			addresses[endpoint.TargetRef.Name] = net.JoinHostPort(endpoint.Addresses[0], port)
+		}
+	}
+
+	members := make([]string, 0, len(addresses))
+	for member := range addresses {
+		members = append(members, member)
+	}
+
+	ring := hashring.New(members, router.virtualNodes)
+
+	if !slices.Equal(ring.Members(), router.table.Load().ring.Members()) {
+		router.observer.UpdatePeers(ctx, observer.UpdatePeers{Peers: ring.Members()})
+	}
+
+	router.table.Store(&routingTable{ring: ring, addresses: addresses})
+}
+
+// HTTP client for forwarding, rebuilt when the CA file changes
+// so that rotated serving certificates are trusted without restarting.
+type peerClient struct {
+	clk         clock.Clock
+	caFile      string
+	serverName  string
+	dialTimeout time.Duration
+
+	mu       sync.Mutex
+	caPem    []byte
+	loadTime time.Time
+	client   *http.Client
+}
+
+func (pc *peerClient) get() (*http.Client, error) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	if pc.client != nil && (pc.caFile == "" || pc.clk.Since(pc.loadTime) < caReloadInterval) {
+		return pc.client, nil
+	}
+
+	caPem := []byte(nil)
+
+	if pc.caFile != "" {
+		var err error
+
+		caPem, err = os.ReadFile(pc.caFile)
+		if err != nil {
+			return nil, errors.TagWrapf("ReadPeerCaFile", err, "read peer CA file")
+		}
+
+		pc.loadTime = pc.clk.Now()
+
+		if pc.client != nil && bytes.Equal(caPem, pc.caPem) {
+			return pc.client, nil
+		}
+	}
+
+	//nolint:exhaustruct // tls.Config is not intended to be exhausted
+	tlsConfig := &tls.Config{
+		ServerName: pc.serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if len(caPem) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPem) {
+			return nil, errors.TagErrorf("ParsePeerCaFile", "no certificates found in peer CA file")
+		}
+
+		tlsConfig.RootCAs = pool
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone() //nolint:forcetypeassert // documented type
+	transport.TLSClientConfig = tlsConfig
+	//nolint:exhaustruct // defaults
+	transport.DialContext = (&net.Dialer{Timeout: pc.dialTimeout, KeepAlive: 30 * time.Second}).DialContext
+	transport.TLSHandshakeTimeout = pc.dialTimeout
+
+	if pc.client != nil {
+		pc.client.CloseIdleConnections()
+	}
+
+	pc.caPem = caPem
+	pc.client = &http.Client{Transport: transport} //nolint:exhaustruct // defaults
+
+	return pc.client, nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/json"
+
+	"github.com/kubewharf/podseidon/util/errors"
+
+	"github.com/kubewharf/podseidon/webhook/handler"
+	"github.com/kubewharf/podseidon/webhook/observer"
+	"github.com/kubewharf/podseidon/webhook/peer"
+)
+
+// Forwards the review to the replica owning its PodProtector on the consistent hash ring.
+//
+// Returns the response body of the peer,
+// or false if the review should be processed locally,
+// either because this replica owns it or because the peer could not be reached.
+// The pod resolved for routing is also returned (nil if it was not resolved),
+// so that it is not fetched again when the review is processed locally.
+func forwardToOwner(
+	ctx context.Context,
+	router *peer.Router,
+	api handler.Api,
+	obs observer.Observer,
+	req *http.Request,
+	cellId string,
+	review *admissionv1.AdmissionReview,
+	body []byte,
+) (_respBody []byte, _forwarded bool, _pod *corev1.Pod) {
+	if !router.Enabled() || req.Header.Get(peer.ForwardedByHeader) != "" {
+		// Forwarded reviews are never forwarded again, even if the rings of the two replicas disagree.
+		return nil, false, nil
+	}
+
+	keyOpt, pod := api.RoutingKey(ctx, review.Request, cellId)
+
+	key, hasKey := keyOpt.Get()
+	if !hasKey {
+		return nil, false, pod
+	}
+
+	owner, isRemote := router.Owner(handler.RingKey(key)).Get()
+	if !isRemote {
+		return nil, false, pod
+	}
+
+	startTime := time.Now()
+
+	respBody, err := forwardReview(ctx, router, owner, req, review, body, pod)
+
+	obs.ForwardReview(ctx, observer.ForwardReview{
+		Namespace: key.Namespace,
+		PprName:   key.Name,
+		Cell:      cellId,
+		Peer:      owner.Name,
+		Latency:   time.Since(startTime),
+		Err:       err,
+	})
+
+	return respBody, err == nil, pod
+}
+
+func forwardReview(
+	ctx context.Context,
+	router *peer.Router,
+	owner peer.Peer,
+	req *http.Request,
+	review *admissionv1.AdmissionReview,
+	body []byte,
+	pod *corev1.Pod,
+) ([]byte, error) {
+	// Evictions do not carry the pod in oldObject; attach the resolved pod to avoid fetching it again.
+	if len(review.Request.OldObject.Raw) == 0 && pod != nil {
+		podJson, err := json.Marshal(pod)
+		if err != nil {
+			return nil, errors.TagWrapf("MarshalPod", err, "marshal pod for forwarded review")
+		}
+
+		withPod := review.DeepCopy()
+		withPod.Request.OldObject.Raw = podJson
+
+		body, err = json.Marshal(withPod)
+		if err != nil {
+			return nil, errors.TagWrapf("MarshalReview", err, "marshal forwarded review")
+		}
+	}
+
+	// The deadline of ctx already excludes the timeout margin of this replica,
+	// and only a fraction of the remaining time is forwarded to the peer,
+	// so the peer processes until the forwarded timeout without subtracting its own margin again.
+	return router.Forward(ctx, owner, req.URL.Path, body)
+}
@@ -0,0 +1,277 @@
+// Copyright 2026 The Podseidon Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+
+	podseidonv1a1 "github.com/kubewharf/podseidon/apis/v1alpha1"
+
+	"github.com/kubewharf/podseidon/util/cmd"
+	"github.com/kubewharf/podseidon/util/component"
+	"github.com/kubewharf/podseidon/util/defaultconfig"
+	"github.com/kubewharf/podseidon/util/errors"
+	"github.com/kubewharf/podseidon/util/kube"
+	"github.com/kubewharf/podseidon/util/o11y"
+	"github.com/kubewharf/podseidon/util/optional"
+	pprutil "github.com/kubewharf/podseidon/util/podprotector"
+
+	"github.com/kubewharf/podseidon/webhook/handler"
+	"github.com/kubewharf/podseidon/webhook/observer"
+	"github.com/kubewharf/podseidon/webhook/peer"
+	"github.com/kubewharf/podseidon/webhook/server"
+)
+
+const forwardTestCellId = "cell"
+
+func TestForwardToOwnerResponds(t *testing.T) {
+	t.Parallel()
+
+	env := setupForwardTest(t, func(resp http.ResponseWriter, _ *http.Request) {
+		_, _ = resp.Write([]byte("peer response"))
+	})
+
+	respBody, forwarded, pod := env.forward(t)
+	assert.True(t, forwarded)
+	assert.Equal(t, "peer response", string(respBody))
+	assert.NotNil(t, pod)
+	assert.NoError(t, env.lastForwardErr(t))
+}
+
+func TestForwardToOwnerFallsBackOnTimeout(t *testing.T) {
+	t.Parallel()
+
+	// Registered after setupForwardTest so that the handler is released before the peer server is closed.
+	release := make(chan struct{})
+	env := setupForwardTest(t, func(http.ResponseWriter, *http.Request) {
+		<-release
+	})
+	t.Cleanup(func() { close(release) })
+
+	_, forwarded, pod := env.forward(t)
+	assert.False(t, forwarded, "review should be processed locally if the peer does not respond in time")
+	assert.NotNil(t, pod, "the resolved pod should be reused for local processing")
+	assert.Error(t, env.lastForwardErr(t))
+}
+
+func TestForwardToOwnerFallsBackOnErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	env := setupForwardTest(t, func(resp http.ResponseWriter, _ *http.Request) {
+		resp.WriteHeader(http.StatusInternalServerError)
+	})
+
+	_, forwarded, pod := env.forward(t)
+	assert.False(t, forwarded, "review should be processed locally if the peer responds with a non-200 status")
+	assert.NotNil(t, pod, "the resolved pod should be reused for local processing")
+	assert.ErrorContains(t, env.lastForwardErr(t), "HTTP 500")
+}
+
+type forwardTestEnv struct {
+	ctx           context.Context //nolint:containedctx // test scope
+	api           handler.Api
+	router        *peer.Router
+	obs           observer.Observer
+	forwardEvents chan observer.ForwardReview
+}
+
+// Starts a webhook replica named "self" whose only peer "other" is served by `peerHandler`,
+// with a PodProtector matching the pod under review.
+func setupForwardTest(t *testing.T, peerHandler http.HandlerFunc) *forwardTestEnv {
+	t.Helper()
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	t.Cleanup(cancelFunc)
+
+	peerServer := httptest.NewServer(peerHandler)
+	t.Cleanup(peerServer.Close)
+
+	peerHost, peerPortStr, err := net.SplitHostPort(peerServer.Listener.Addr().String())
+	require.NoError(t, err)
+
+	peerPort, err := strconv.ParseInt(peerPortStr, 10, 32)
+	require.NoError(t, err)
+
+	//nolint:exhaustruct
+	ppr := &podseidonv1a1.PodProtector{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: podseidonv1a1.SchemeGroupVersion.String(),
+			Kind:       podseidonv1a1.PodProtectorKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceDefault, Name: "ppr"},
+		Spec: podseidonv1a1.PodProtectorSpec{
+			MinAvailable: 1,
+			Selector:     metav1.LabelSelector{MatchLabels: map[string]string{"test": "true"}},
+		},
+	}
+
+	//nolint:exhaustruct
+	endpointSlice := &discoveryv1.EndpointSlice{
+		TypeMeta: metav1.TypeMeta{APIVersion: discoveryv1.SchemeGroupVersion.String(), Kind: "EndpointSlice"},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      "podseidon-webhook-peers",
+			Labels:    map[string]string{discoveryv1.LabelServiceName: "podseidon-webhook"},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+		Ports: []discoveryv1.EndpointPort{
+			{Name: ptr.To("webhook"), Port: ptr.To(int32(peerPort))},
+		},
+		Endpoints: []discoveryv1.Endpoint{
+			{
+				Addresses: []string{peerHost},
+				TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "other"},
+			},
+		},
+	}
+
+	client := kube.MockClient(ppr, endpointSlice)
+
+	forwardEvents := make(chan observer.ForwardReview, 16)
+
+	//nolint:exhaustruct
+	obs := o11y.ReflectPopulate(observer.Observer{
+		ForwardReview: func(_ context.Context, arg observer.ForwardReview) {
+			forwardEvents <- arg
+		},
+	})
+
+	apiMap := cmd.MockStartupWithCliArgs(ctx, []func(*component.DepRequests){
+		component.ApiOnly("core-kube", client),
+		component.ApiOnly("observer-webhook", obs),
+		component.ApiOnly("default-admission-history-config", &defaultconfig.Options{
+			MaxConcurrentLag:   ptr.To(int32(0)),
+			CompactThreshold:   ptr.To(int32(100)),
+			AggregationRate:    ptr.To(time.Duration(0)),
+			StaleCellThreshold: ptr.To(time.Duration(0)),
+			StaleCellPolicy:    ptr.To(podseidonv1a1.StaleCellPolicyEstimated),
+		}),
+		component.ApiOnly[handler.RequiresPodName](handler.RequiresPodNameMuxName, handler.ConstantRequiresPodName(true)),
+		component.ApiOnly[handler.DeleterIdentity](handler.DeleterIdentityMuxName, forwardTestDeps{}),
+		component.ApiOnly[handler.PodGetter](handler.PodGetterMuxName, forwardTestDeps{}),
+		component.RequireDep(handler.New(handler.Args{Clock: clock.RealClock{}})),
+		component.RequireDep(peer.New(peer.Args{Clock: clock.RealClock{}})),
+		component.RequireDep(pprutil.NewIndexedInformer(pprutil.IndexedInformerArgs{
+			Suffix:  "",
+			Elector: optional.None[kube.ElectorArgs](),
+		})),
+		pprutil.RequireSingleSourceProvider(pprutil.SingleSourceProviderArgs{ClusterName: "core"}, true),
+	}, []string{
+		"--webhook-peer-enable",
+		"--webhook-peer-identity=self",
+		"--webhook-peer-scheme=http",
+		"--webhook-peer-response-timeout=200ms",
+	})
+
+	env := &forwardTestEnv{
+		ctx:           ctx,
+		api:           component.ApiFromMap[handler.Api](apiMap, "webhook-handler"),
+		router:        component.ApiFromMap[*peer.Router](apiMap, "webhook-peer"),
+		obs:           obs,
+		forwardEvents: forwardEvents,
+	}
+
+	informer := component.ApiFromMap[pprutil.IndexedInformer](apiMap, "podprotector-indexed-informer-")
+	require.Eventually(t, informer.HasSynced, time.Second*5, time.Millisecond*10)
+
+	require.Eventually(t, func() bool {
+		return env.router.Owner("any").IsSome()
+	}, time.Second*5, time.Millisecond*10, "peer should be discovered from the EndpointSlice")
+
+	return env
+}
+
+// Records no deleter identity and never fetches pods, since reviews in these tests always include oldObject.
+type forwardTestDeps struct{}
+
+func (forwardTestDeps) DeleterIdentity(handler.DeleterIdentityArg) string { return "" }
+
+func (forwardTestDeps) GetPod(context.Context, handler.PodGetterArg) (*corev1.Pod, error) {
+	return nil, errors.TagErrorf("UnexpectedGetPod", "reviews in this test include oldObject")
+}
+
+func (env *forwardTestEnv) forward(t *testing.T) (_respBody []byte, _forwarded bool, _pod *corev1.Pod) {
+	t.Helper()
+
+	//nolint:exhaustruct
+	pod := &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+			Name:      "pod",
+			UID:       types.UID("pod"),
+			Labels:    map[string]string{"test": "true"},
+		},
+	}
+
+	podJson, err := json.Marshal(pod)
+	require.NoError(t, err)
+
+	//nolint:exhaustruct
+	review := &admissionv1.AdmissionReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: admissionv1.SchemeGroupVersion.String(), Kind: "AdmissionReview"},
+		Request: &admissionv1.AdmissionRequest{
+			UID:       types.UID("review"),
+			Resource:  metav1.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"},
+			Operation: admissionv1.Delete,
+			Namespace: metav1.NamespaceDefault,
+			Name:      pod.Name,
+			OldObject: runtime.RawExtension{Raw: podJson},
+		},
+	}
+
+	body, err := json.Marshal(review)
+	require.NoError(t, err)
+
+	req := httptest.NewRequestWithContext(env.ctx, http.MethodPost, "/webhook/"+forwardTestCellId, nil)
+
+	ctx, cancelFunc := context.WithTimeout(env.ctx, time.Second*5)
+	defer cancelFunc()
+
+	return server.ForwardToOwner(ctx, env.router, env.api, env.obs, req, forwardTestCellId, review, body)
+}
+
+func (env *forwardTestEnv) lastForwardErr(t *testing.T) error {
+	t.Helper()
+
+	select {
+	case event := <-env.forwardEvents:
+		assert.Equal(t, "other", event.Peer)
+
+		return event.Err
+	default:
+		require.FailNow(t, "forwarding should be reported to the observer")
+
+		return nil
+	}
+}
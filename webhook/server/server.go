@@ -15,10 +15,12 @@
 package server
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -34,8 +36,10 @@ import (
 	"github.com/kubewharf/podseidon/util/optional"
 	"github.com/kubewharf/podseidon/util/util"
 
+	"github.com/kubewharf/podseidon/webhook/certprovider"
 	"github.com/kubewharf/podseidon/webhook/handler"
 	"github.com/kubewharf/podseidon/webhook/observer"
+	"github.com/kubewharf/podseidon/webhook/peer"
 )
 
 const (
@@ -59,12 +63,21 @@ var New = utilhttp.DeclareServer(
 				false,
 				"never reject any deletions, only update PodProtector and emit rejection metrics",
 			),
+			timeoutMargin: fs.Duration(
+				"timeout-margin",
+				time.Second,
+				"time reserved for responding to apiserver before the admission timeout in the request expires, "+
+					"capped at half of the timeout",
+			),
 		}
 	},
 	func(_ Args, reqs *component.DepRequests) Deps {
 		return Deps{
 			observer: o11y.Request[observer.Observer](reqs),
 			handler:  component.DepPtr(reqs, handler.New(handler.Args{Clock: clock.RealClock{}})),
+			// Self-managed certificate files must be written before the HTTPS server loads them.
+			certProvider: component.DepPtr(reqs, certprovider.Request()),
+			peer:         component.DepPtr(reqs, peer.New(peer.Args{Clock: clock.RealClock{}})),
 		}
 	},
 	func(_ Args, options Options, deps Deps, mux *http.ServeMux) (*State, error) {
@@ -82,6 +95,21 @@ var New = utilhttp.DeclareServer(
 				)
 				defer cancelFunc()
 
+				// Stop processing before apiserver gives up on the request,
+				// so that the response is a deliberate verdict instead of the webhook failure policy.
+				if timeout, hasTimeout := parseAdmissionTimeout(req).Get(); hasTimeout {
+					margin := *options.timeoutMargin
+					if req.Header.Get(peer.ForwardedByHeader) != "" {
+						// The forwarding replica has already reserved its margin when computing the forwarded timeout.
+						margin = 0
+					}
+
+					var deadlineCancelFunc context.CancelFunc
+
+					ctx, deadlineCancelFunc = context.WithTimeout(ctx, processingTimeout(timeout, margin))
+					defer deadlineCancelFunc()
+				}
+
 				postBody, err := io.ReadAll(req.Body)
 				if err != nil {
 					err = errors.Tag("ReadBody", err)
@@ -116,9 +144,29 @@ var New = utilhttp.DeclareServer(
 					return
 				}
 
+				respBody, forwarded, routedPod := forwardToOwner(
+					ctx,
+					deps.peer.Get(),
+					deps.handler.Get(),
+					deps.observer.Get(),
+					req,
+					cellId,
+					reviewRequest,
+					postBody,
+				)
+				if forwarded {
+					resp.Header().Set("Content-Type", "application/json")
+
+					if _, err := resp.Write(respBody); err != nil {
+						deps.observer.Get().HttpError(ctx, observer.HttpError{Err: err})
+					}
+
+					return
+				}
+
 				auditAnnotations := map[string]string{}
 				result, preferDryRun := deps.handler.Get().
-					Handle(ctx, reviewRequest.Request, cellId, auditAnnotations)
+					Handle(ctx, reviewRequest.Request, cellId, auditAnnotations, routedPod)
 				dryRun := *options.dryRun || preferDryRun
 
 				if result.Err != nil {
@@ -156,6 +204,7 @@ var New = utilhttp.DeclareServer(
 								Result: optional.Map(result.Rejection, handler.Rejection.ToStatus).
 									GetOrZero(),
 								AuditAnnotations: auditAnnotations,
+								Warnings:         result.Warnings,
 							},
 						})
 
@@ -167,6 +216,12 @@ var New = utilhttp.DeclareServer(
 
 				auditAnnotations[podseidon.AuditAnnotationDryRun] = "1"
 
+				// Warnings are displayed by kubectl, so that users know the deletion would otherwise be rejected.
+				warnings := result.Warnings
+				if rejection, rejected := result.Rejection.Get(); rejected {
+					warnings = append(warnings, fmt.Sprintf("podseidon (dry-run): pod deletion would be rejected: %s", rejection.Message))
+				}
+
 				_ = json.NewEncoder(resp).Encode(&admissionv1.AdmissionReview{
 					TypeMeta: metav1.TypeMeta{
 						APIVersion: admissionv1.SchemeGroupVersion.String(),
@@ -176,6 +231,7 @@ var New = utilhttp.DeclareServer(
 						UID:              reviewRequest.Request.UID,
 						Allowed:          true,
 						AuditAnnotations: auditAnnotations,
+						Warnings:         warnings,
 					},
 				})
 			},
@@ -191,16 +247,39 @@ var New = utilhttp.DeclareServer(
 	func(Args, Options, Deps, *State) util.Empty { return util.Empty{} },
 )
 
+// Parses the `timeout` query parameter that apiserver appends to admission webhook requests.
+func parseAdmissionTimeout(req *http.Request) optional.Optional[time.Duration] {
+	timeout, err := time.ParseDuration(req.URL.Query().Get("timeout"))
+	if err != nil || timeout <= 0 {
+		return optional.None[time.Duration]()
+	}
+
+	return optional.Some(timeout)
+}
+
+// Returns the time available for processing a request with the given admission timeout.
+//
+// The margin is capped at half of the timeout,
+// so that short timeouts (e.g. `timeoutSeconds: 1`) still leave time for processing
+// instead of expiring immediately.
+func processingTimeout(timeout time.Duration, margin time.Duration) time.Duration {
+	return timeout - min(margin, timeout/2)
+}
+
 type Args struct{}
 
 type Options struct {
-	pathPrefix *string
-	dryRun     *bool
+	pathPrefix    *string
+	dryRun        *bool
+	timeoutMargin *time.Duration
 }
 
 type Deps struct {
 	observer component.Dep[observer.Observer]
 	handler  component.Dep[handler.Api]
+
+	certProvider component.Dep[certprovider.Provider]
+	peer         component.Dep[*peer.Router]
 }
 
 type State struct{}